@@ -0,0 +1,55 @@
+package account
+
+import (
+	"context"
+	"time"
+
+	"github.com/divyanshgaba/bingo/player"
+	"github.com/go-kit/kit/endpoint"
+)
+
+type registerRequest struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+type registerResponse struct {
+	Username string `json:"username,omitempty"`
+	Err      error  `json:"error,omitempty"`
+}
+
+func (r registerResponse) error() error { return r.Err }
+
+func makeRegisterEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(registerRequest)
+		err := s.Register(ctx, req.Username, req.Password)
+		if err != nil {
+			return registerResponse{Err: err}, nil
+		}
+		// the player is registered with the username normalized
+		username, _ := player.NormalizeUsername(req.Username)
+		return registerResponse{Username: username}, nil
+	}
+}
+
+type loginRequest struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+type loginResponse struct {
+	Token     string    `json:"token,omitempty"`
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	Err       error     `json:"error,omitempty"`
+}
+
+func (r loginResponse) error() error { return r.Err }
+
+func makeLoginEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(loginRequest)
+		token, expiresAt, err := s.Login(ctx, req.Username, req.Password)
+		return loginResponse{Token: token, ExpiresAt: expiresAt, Err: err}, nil
+	}
+}
@@ -0,0 +1,66 @@
+package account
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/divyanshgaba/bingo/player"
+	"github.com/go-kit/kit/endpoint"
+	"google.golang.org/grpc/metadata"
+)
+
+type contextKey int
+
+const tokenKey contextKey = iota
+
+// HTTPToContext moves the session token of a request into the context, from an `Authorization: Bearer` header
+// or else the session cookie.
+func HTTPToContext(ctx context.Context, r *http.Request) context.Context {
+	if h := r.Header.Get("Authorization"); strings.HasPrefix(h, "Bearer ") {
+		return context.WithValue(ctx, tokenKey, strings.TrimPrefix(h, "Bearer "))
+	}
+	if c, err := r.Cookie(SessionCookie); err == nil {
+		return context.WithValue(ctx, tokenKey, c.Value)
+	}
+	return ctx
+}
+
+// GRPCToContext moves the session token of a gRPC call into the context, from `authorization: Bearer` metadata.
+func GRPCToContext(ctx context.Context, md metadata.MD) context.Context {
+	for _, h := range md.Get("authorization") {
+		if strings.HasPrefix(h, "Bearer ") {
+			return context.WithValue(ctx, tokenKey, strings.TrimPrefix(h, "Bearer "))
+		}
+	}
+	return ctx
+}
+
+// Authenticate returns a middleware which only lets requests with a valid session token through. The username of the
+// authenticated player is passed on in the context, see player.FromContext.
+func Authenticate(s Service) endpoint.Middleware {
+	return func(next endpoint.Endpoint) endpoint.Endpoint {
+		return func(ctx context.Context, request interface{}) (interface{}, error) {
+			token, _ := ctx.Value(tokenKey).(string)
+			username, err := s.Authenticate(ctx, token)
+			if err != nil {
+				return nil, err
+			}
+			return next(player.NewContext(ctx, username), request)
+		}
+	}
+}
+
+// Identify returns a middleware which passes on the username of the player authenticated by the session token in
+// the context, like Authenticate, but lets requests without a valid session token through as anonymous.
+func Identify(s Service) endpoint.Middleware {
+	return func(next endpoint.Endpoint) endpoint.Endpoint {
+		return func(ctx context.Context, request interface{}) (interface{}, error) {
+			token, _ := ctx.Value(tokenKey).(string)
+			if username, err := s.Authenticate(ctx, token); err == nil {
+				ctx = player.NewContext(ctx, username)
+			}
+			return next(ctx, request)
+		}
+	}
+}
@@ -0,0 +1,132 @@
+package account
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/divyanshgaba/bingo/errs"
+	"github.com/divyanshgaba/bingo/player"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// errors for package account
+var (
+	ErrInvalidArgument    = errs.New("account.invalid_argument", http.StatusBadRequest, "account: invalid argument")
+	ErrInvalidCredentials = errs.New("account.invalid_credentials", http.StatusUnauthorized, "account: invalid username or password")
+	ErrUnauthenticated    = errs.New("account.unauthenticated", http.StatusUnauthorized, "account: missing or invalid session token")
+)
+
+// Service is the interface that provides player accounts.
+type Service interface {
+	// register a new player with username and password
+	Register(ctx context.Context, username, password string) error
+
+	// log a player in, returns a session token valid until expiresAt
+	Login(ctx context.Context, username, password string) (token string, expiresAt time.Time, err error)
+
+	// returns username of the player a session token was issued to
+	Authenticate(ctx context.Context, token string) (string, error)
+}
+
+// how long a session token is valid for
+const sessionTTL = 24 * time.Hour
+
+// limits for credentials, bcrypt only uses the first 72 bytes of a password
+const (
+	minPasswordLength = 8
+	maxPasswordLength = 72
+)
+
+var errPasswordLength = fmt.Errorf("must be %d to %d bytes long", minPasswordLength, maxPasswordLength)
+
+type service struct {
+	players player.Repository
+}
+
+// NewService returns implementation of `Service`.
+func NewService(players player.Repository) Service {
+	return &service{
+		players: players,
+	}
+}
+
+func (s service) Register(ctx context.Context, username, password string) error {
+	var fields []errs.Field
+	username, err := player.NormalizeUsername(username)
+	if err != nil {
+		fields = append(fields, errs.Field{Name: "username", Reason: err.Error()})
+	}
+	if len(password) < minPasswordLength || len(password) > maxPasswordLength {
+		fields = append(fields, errs.Field{Name: "password", Reason: errPasswordLength.Error()})
+	}
+	if len(fields) > 0 {
+		return ErrInvalidArgument.WithFields(fields...)
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
+	return s.players.Insert(ctx, player.Player{Username: username, PasswordHash: hash, CreatedAt: time.Now().UTC()})
+}
+
+func (s service) Login(ctx context.Context, username, password string) (string, time.Time, error) {
+	username, err := player.NormalizeUsername(username)
+	if err != nil {
+		return "", time.Time{}, ErrInvalidCredentials
+	}
+	p, err := s.players.Find(ctx, username)
+	if errors.Is(err, player.ErrNotFound) {
+		return "", time.Time{}, ErrInvalidCredentials
+	}
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	if bcrypt.CompareHashAndPassword(p.PasswordHash, []byte(password)) != nil {
+		return "", time.Time{}, ErrInvalidCredentials
+	}
+	token, err := newToken()
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	expiresAt := time.Now().Add(sessionTTL).UTC()
+	err = s.players.InsertSession(ctx, player.Session{TokenHash: hashToken(token), Username: username, ExpiresAt: expiresAt})
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	return token, expiresAt, nil
+}
+
+func (s service) Authenticate(ctx context.Context, token string) (string, error) {
+	if token == "" {
+		return "", ErrUnauthenticated
+	}
+	sess, err := s.players.FindSession(ctx, hashToken(token))
+	if errors.Is(err, player.ErrInvalidToken) {
+		return "", ErrUnauthenticated
+	}
+	if err != nil {
+		return "", err
+	}
+	return sess.Username, nil
+}
+
+// newToken returns a random opaque session token.
+func newToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// hashToken returns the hash a session token is stored by, so that stored sessions cannot be used as tokens.
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,89 @@
+package account
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/divyanshgaba/bingo/errs"
+	"github.com/gorilla/mux"
+
+	kitlog "github.com/go-kit/kit/log"
+	kithttp "github.com/go-kit/kit/transport/http"
+)
+
+// SessionCookie is the cookie the session token is set in on login, for browsers.
+const SessionCookie = "bingo_session"
+
+// MakeHandler returns a handler for the account service.
+func MakeHandler(as Service, logger kitlog.Logger) http.Handler {
+	opts := []kithttp.ServerOption{
+		kithttp.ServerErrorHandler(errs.NewLogErrorHandler(logger)),
+		kithttp.ServerErrorEncoder(errs.EncodeError),
+	}
+
+	registerHandler := kithttp.NewServer(
+		makeRegisterEndpoint(as),
+		decodeRegisterRequest,
+		encodeResponse,
+		opts...,
+	)
+
+	loginHandler := kithttp.NewServer(
+		makeLoginEndpoint(as),
+		decodeLoginRequest,
+		encodeLoginResponse,
+		opts...,
+	)
+
+	r := mux.NewRouter()
+
+	r.Handle("/api/player/register", registerHandler).Methods(http.MethodPost)
+	r.Handle("/api/player/login", loginHandler).Methods(http.MethodPost)
+
+	return r
+}
+
+func decodeRegisterRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req registerRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, ErrInvalidArgument
+	}
+	return req, nil
+}
+
+func decodeLoginRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req loginRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, ErrInvalidArgument
+	}
+	return req, nil
+}
+
+// encodeLoginResponse returns the session token in the body and sets it as cookie, so that browsers can view tickets.
+func encodeLoginResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	if e, ok := response.(loginResponse); ok && e.error() == nil {
+		http.SetCookie(w, &http.Cookie{
+			Name:     SessionCookie,
+			Value:    e.Token,
+			Path:     "/",
+			Expires:  e.ExpiresAt,
+			HttpOnly: true,
+			SameSite: http.SameSiteLaxMode,
+		})
+	}
+	return encodeResponse(ctx, w, response)
+}
+
+// encodeResponse encodes response as JSON, errors from business-logic are returned for the server to encode.
+func encodeResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	if e, ok := response.(errorer); ok && e.error() != nil {
+		return e.error()
+	}
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	return json.NewEncoder(w).Encode(response)
+}
+
+type errorer interface {
+	error() error
+}
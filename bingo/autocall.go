@@ -0,0 +1,143 @@
+package bingo
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/divyanshgaba/bingo/game"
+)
+
+// limits for the interval numbers are drawn at automatically
+const (
+	defaultAutoCallInterval = 5 * time.Second
+	minAutoCallInterval     = 100 * time.Millisecond
+	maxAutoCallInterval     = 10 * time.Minute
+)
+
+// autoCallers keeps a stop channel for every game whose numbers this process draws automatically.
+type autoCallers struct {
+	mtx   sync.Mutex
+	stops map[game.ID]chan struct{}
+}
+
+func newAutoCallers() *autoCallers {
+	return &autoCallers{stops: make(map[game.ID]chan struct{})}
+}
+
+// start runs call every interval for a game until stop is called or call returns false.
+// A caller already running for the game is replaced.
+func (c *autoCallers) start(gameID game.ID, interval time.Duration, call func() bool) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	if stop, ok := c.stops[gameID]; ok {
+		close(stop)
+	}
+	stop := make(chan struct{})
+	c.stops[gameID] = stop
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+			case <-stop:
+				return
+			}
+			if !call() {
+				c.remove(gameID, stop)
+				return
+			}
+		}
+	}()
+}
+
+// stop stops the caller running for a game, if any.
+func (c *autoCallers) stop(gameID game.ID) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	if stop, ok := c.stops[gameID]; ok {
+		close(stop)
+		delete(c.stops, gameID)
+	}
+}
+
+// remove forgets the caller of a game which stopped by itself, unless it has been replaced already.
+func (c *autoCallers) remove(gameID game.ID, stop chan struct{}) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	if c.stops[gameID] == stop {
+		delete(c.stops, gameID)
+	}
+}
+
+func (s service) StartAutoCall(ctx context.Context, gameID game.ID, interval time.Duration) error {
+	if interval < minAutoCallInterval || interval > maxAutoCallInterval {
+		return ErrInvalidArgument
+	}
+	g, err := s.games.Find(ctx, gameID)
+	if err != nil {
+		return err
+	}
+	if g.Status == game.Finished {
+		return ErrGameNotRunning
+	}
+	if err := s.games.SetAutoCall(ctx, gameID, interval); err != nil {
+		return err
+	}
+	s.callers.start(gameID, interval, func() bool { return s.autoCall(gameID) })
+	return nil
+}
+
+func (s service) StopAutoCall(ctx context.Context, gameID game.ID) error {
+	s.callers.stop(gameID)
+	return s.games.SetAutoCall(ctx, gameID, 0)
+}
+
+// autoCall draws the next number of a game whose numbers are drawn automatically, and reports whether to keep
+// drawing. Draws are skipped until the game is started and while it is paused, and drawing stops once the game
+// finishes, by a full house or by drawing all numbers. Errors are retried at the next interval.
+//
+// Every instance of the service which started or resumed auto-calling a game ticks for it, so a tick is also
+// skipped when a number was drawn within most of the interval already. Whichever instance ticks first draws.
+func (s service) autoCall(gameID game.ID) bool {
+	ctx := context.Background()
+	g, err := s.games.Find(ctx, gameID)
+	if errors.Is(err, game.ErrInvalidID) {
+		return false
+	}
+	if err != nil {
+		return true
+	}
+	if g.AutoCall == 0 { // stopped by another instance of the service
+		return false
+	}
+	switch g.Status {
+	case game.Lobby, game.Paused:
+		return true
+	case game.Finished:
+		s.games.SetAutoCall(ctx, gameID, 0)
+		return false
+	}
+	if n := len(g.Draws); n > 0 && time.Since(g.Draws[n-1].DrawnAt) < g.AutoCall*9/10 {
+		return true
+	}
+	s.draw(ctx, gameID, true, "")
+	return true
+}
+
+// ResumeAutoCalls starts drawing numbers automatically again for all games which had it on, when the service
+// is restarted.
+func ResumeAutoCalls(ctx context.Context, s Service, games game.Repository) error {
+	gs, err := games.FindAutoCalled(ctx)
+	if err != nil {
+		return err
+	}
+	for _, g := range gs {
+		if err := s.StartAutoCall(ctx, g.ID, g.AutoCall); err != nil && !errors.Is(err, ErrGameNotRunning) {
+			return err
+		}
+	}
+	return nil
+}
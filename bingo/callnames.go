@@ -0,0 +1,128 @@
+package bingo
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/divyanshgaba/bingo/game"
+)
+
+// locales of call names numbers are announced with
+const (
+	LocalePlain  = "plain"  // the number in words, the default
+	LocaleUK     = "uk"     // traditional UK bingo calls
+	LocaleCustom = "custom" // call names uploaded by the host of the game
+)
+
+// longest custom call name, in bytes
+const maxCallNameLength = 100
+
+// ukCalls are the traditional UK call names for numbers 1-90.
+var ukCalls = map[int64]string{
+	1: "Kelly's eye", 2: "One little duck", 3: "Cup of tea", 4: "Knock at the door", 5: "Man alive",
+	6: "Tom Mix", 7: "Lucky seven", 8: "Garden gate", 9: "Doctor's orders", 10: "Cock and hen",
+	11: "Legs eleven", 12: "One dozen", 13: "Unlucky for some", 14: "Valentine's Day", 15: "Young and keen",
+	16: "Sweet sixteen", 17: "Dancing queen", 18: "Coming of age", 19: "Goodbye teens", 20: "One score",
+	21: "Key of the door", 22: "Two little ducks", 23: "Thee and me", 24: "Two dozen", 25: "Duck and dive",
+	26: "Pick and mix", 27: "Gateway to heaven", 28: "In a state", 29: "Rise and shine", 30: "Dirty Gertie",
+	31: "Get up and run", 32: "Buckle my shoe", 33: "All the threes", 34: "Ask for more", 35: "Jump and jive",
+	36: "Three dozen", 37: "More than eleven", 38: "Christmas cake", 39: "Thirty-nine steps", 40: "Naughty forty",
+	41: "Time for fun", 42: "Winnie the Pooh", 43: "Down on your knees", 44: "Droopy drawers", 45: "Halfway there",
+	46: "Up to tricks", 47: "Four and seven", 48: "Four dozen", 49: "P.C.", 50: "Half a century",
+	51: "Tweak of the thumb", 52: "Danny La Rue", 53: "Stuck in the tree", 54: "Clean the floor", 55: "Snakes alive",
+	56: "Was she worth it?", 57: "Heinz varieties", 58: "Make them wait", 59: "Brighton line", 60: "Five dozen",
+	61: "Baker's bun", 62: "Tickety-boo", 63: "Tickle me", 64: "Red raw", 65: "Old age pension",
+	66: "Clickety click", 67: "Stairway to heaven", 68: "Saving grace", 69: "Either way up", 70: "Three score and ten",
+	71: "Bang on the drum", 72: "Six dozen", 73: "Queen bee", 74: "Candy store", 75: "Strive and strive",
+	76: "Trombones", 77: "Sunset strip", 78: "Thirty-nine more steps", 79: "One more time", 80: "Eight and blank",
+	81: "Stop and run", 82: "Straight on through", 83: "Time for tea", 84: "Seven dozen", 85: "Staying alive",
+	86: "Between the sticks", 87: "Torquay in Devon", 88: "Two fat ladies", 89: "Nearly there", 90: "Top of the shop",
+}
+
+// validCalls reports whether calls can be set for a game of format f. Custom names must be for numbers drawn
+// in the game.
+func validCalls(calls game.Calls, f game.Format) bool {
+	switch calls.Locale {
+	case "", LocalePlain, LocaleUK:
+		return len(calls.Custom) == 0
+	case LocaleCustom:
+	default:
+		return false
+	}
+	for n, name := range calls.Custom {
+		if n < f.Min || n > f.Max || strings.TrimSpace(name) == "" || len(name) > maxCallNameLength {
+			return false
+		}
+	}
+	return true
+}
+
+// validLocale reports whether numbers can be announced in locale, empty picks the locale of the game.
+func validLocale(locale string) bool {
+	switch locale {
+	case "", LocalePlain, LocaleUK, LocaleCustom:
+		return true
+	default:
+		return false
+	}
+}
+
+// announce returns the text a caller reads out for number drawn in game g, with call names of locale or else
+// those set for the game. Numbers without a call name are announced in words, prefixed with the letter of their
+// column for 75-ball games.
+func announce(g game.Game, locale string, number int64) string {
+	if locale == "" {
+		locale = g.Calls.Locale
+	}
+	plain := numberWords(number)
+	format := g.Format.OrClassic()
+	if format.Name == game.Format75Ball {
+		plain = string("BINGO"[(number-format.Min)/15]) + ", " + plain
+	}
+	var name string
+	switch locale {
+	case LocaleUK:
+		name = ukCalls[number]
+	case LocaleCustom:
+		name = g.Calls.Custom[number]
+	}
+	if name != "" {
+		plain = name + ", " + plain
+	}
+	return strings.ToUpper(plain[:1]) + plain[1:]
+}
+
+var (
+	smallNumbers = []string{"zero", "one", "two", "three", "four", "five", "six", "seven", "eight", "nine", "ten",
+		"eleven", "twelve", "thirteen", "fourteen", "fifteen", "sixteen", "seventeen", "eighteen", "nineteen"}
+	tens = []string{"", "", "twenty", "thirty", "forty", "fifty", "sixty", "seventy", "eighty", "ninety"}
+)
+
+// numberWords returns n in English words, such as "twenty-two", for numbers from 0 to 999 and digits otherwise.
+func numberWords(n int64) string {
+	if n < 0 || n > 999 {
+		return strconv.FormatInt(n, 10)
+	}
+	words := belowHundred(n % 100)
+	if n >= 100 {
+		hundreds := smallNumbers[n/100] + " hundred"
+		if n%100 == 0 {
+			words = hundreds
+		} else {
+			words = hundreds + " and " + words
+		}
+	}
+	return words
+}
+
+// belowHundred returns n, less than 100, in words.
+func belowHundred(n int64) string {
+	switch {
+	case n < 20:
+		return smallNumbers[n]
+	case n%10 == 0:
+		return tens[n/10]
+	default:
+		return tens[n/10] + "-" + smallNumbers[n%10]
+	}
+}
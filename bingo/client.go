@@ -0,0 +1,625 @@
+package bingo
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/divyanshgaba/bingo/errs"
+	"github.com/divyanshgaba/bingo/game"
+	"github.com/divyanshgaba/bingo/ticket"
+	"github.com/go-kit/kit/endpoint"
+
+	kithttp "github.com/go-kit/kit/transport/http"
+)
+
+// NewHostSecretContext returns a context carrying the host secret of a game, which a client made with
+// NewHTTPClient sends along with host operations.
+func NewHostSecretContext(ctx context.Context, secret string) context.Context {
+	return context.WithValue(ctx, hostSecretKey, secret)
+}
+
+// hostSecretToHTTP moves the host secret in the context into the header of a request.
+func hostSecretToHTTP(ctx context.Context, r *http.Request) context.Context {
+	if secret, ok := ctx.Value(hostSecretKey).(string); ok && secret != "" {
+		r.Header.Set(HostSecretHeader, secret)
+	}
+	return ctx
+}
+
+// httpClient is a Service backed by the HTTP API of a remote bingo service, see MakeHandler.
+type httpClient struct {
+	createGame        endpoint.Endpoint
+	authorizeHost     endpoint.Endpoint
+	createTicket      endpoint.Endpoint
+	createTicketStrip endpoint.Endpoint
+	showTicket        endpoint.Endpoint
+	listTickets       endpoint.Endpoint
+	markNumber        endpoint.Endpoint
+	setAutoDaub       endpoint.Endpoint
+	generateNumber    endpoint.Endpoint
+	getDraws          endpoint.Endpoint
+	setCalls          endpoint.Endpoint
+	getStats          endpoint.Endpoint
+	claimPrize        endpoint.Endpoint
+	watch             endpoint.Endpoint
+	startGame         endpoint.Endpoint
+	pauseGame         endpoint.Endpoint
+	resumeGame        endpoint.Endpoint
+	endGame           endpoint.Endpoint
+	getSeed           endpoint.Endpoint
+	autoCall          endpoint.Endpoint
+}
+
+// NewHTTPClient returns a Service which calls the HTTP API of the bingo service at instance, such as
+// "localhost:8080". Host operations are sent with the host secret in their context, see NewHostSecretContext.
+func NewHTTPClient(instance string, opts ...kithttp.ClientOption) (Service, error) {
+	if !strings.HasPrefix(instance, "http") {
+		instance = "http://" + instance
+	}
+	tgt, err := url.Parse(instance)
+	if err != nil {
+		return nil, err
+	}
+	tgt.Path = ""
+	opts = append([]kithttp.ClientOption{kithttp.ClientBefore(hostSecretToHTTP)}, opts...)
+	return httpClient{
+		createGame:        kithttp.NewClient(http.MethodPost, tgt, encodeCreateGameRequest, decodeCreateGameResponse, opts...).Endpoint(),
+		authorizeHost:     kithttp.NewClient(http.MethodPost, tgt, encodeAuthorizeHostRequest, decodeAuthorizeHostResponse, opts...).Endpoint(),
+		createTicket:      kithttp.NewClient(http.MethodPost, tgt, encodeCreateTicketRequest, decodeCreateTicketResponse, opts...).Endpoint(),
+		createTicketStrip: kithttp.NewClient(http.MethodPost, tgt, encodeCreateTicketStripRequest, decodeCreateTicketStripResponse, opts...).Endpoint(),
+		showTicket:        kithttp.NewClient(http.MethodGet, tgt, encodeShowTicketRequest, decodeShowTicketResponse, opts...).Endpoint(),
+		listTickets:       kithttp.NewClient(http.MethodGet, tgt, encodeListTicketsRequest, decodeListTicketsResponse, opts...).Endpoint(),
+		markNumber:        kithttp.NewClient(http.MethodPost, tgt, encodeMarkNumberRequest, decodeMarkNumberResponse, opts...).Endpoint(),
+		setAutoDaub:       kithttp.NewClient(http.MethodPost, tgt, encodeSetAutoDaubRequest, decodeSetAutoDaubResponse, opts...).Endpoint(),
+		generateNumber:    kithttp.NewClient(http.MethodGet, tgt, encodeGenerateNumberRequest, decodeGenerateNumberResponse, opts...).Endpoint(),
+		getDraws:          kithttp.NewClient(http.MethodGet, tgt, encodeGetDrawsRequest, decodeGetDrawsResponse, opts...).Endpoint(),
+		setCalls:          kithttp.NewClient(http.MethodPost, tgt, encodeSetCallsRequest, decodeSetCallsResponse, opts...).Endpoint(),
+		getStats:          kithttp.NewClient(http.MethodGet, tgt, encodeGetStatsRequest, decodeGetStatsResponse, opts...).Endpoint(),
+		claimPrize:        kithttp.NewClient(http.MethodPost, tgt, encodeClaimPrizeRequest, decodeClaimPrizeResponse, opts...).Endpoint(),
+		watch: kithttp.NewClient(http.MethodGet, tgt, encodeWatchRequest, decodeWatchResponse,
+			append(opts, kithttp.BufferedStream(true))...).Endpoint(),
+		startGame:  kithttp.NewClient(http.MethodPost, tgt, encodeChangeStatusRequest("start"), decodeChangeStatusResponse, opts...).Endpoint(),
+		pauseGame:  kithttp.NewClient(http.MethodPost, tgt, encodeChangeStatusRequest("pause"), decodeChangeStatusResponse, opts...).Endpoint(),
+		resumeGame: kithttp.NewClient(http.MethodPost, tgt, encodeChangeStatusRequest("resume"), decodeChangeStatusResponse, opts...).Endpoint(),
+		endGame:    kithttp.NewClient(http.MethodPost, tgt, encodeChangeStatusRequest("end"), decodeChangeStatusResponse, opts...).Endpoint(),
+		getSeed:    kithttp.NewClient(http.MethodGet, tgt, encodeGetSeedRequest, decodeGetSeedResponse, opts...).Endpoint(),
+		autoCall:   kithttp.NewClient(http.MethodPost, tgt, encodeAutoCallRequest, decodeAutoCallResponse, opts...).Endpoint(),
+	}, nil
+}
+
+func (c httpClient) CreateGame(ctx context.Context, f game.Format, r game.Rules) (game.ID, string, error) {
+	resp, err := c.createGame(ctx, createGameRequest{Format: f, Rules: r})
+	if err != nil {
+		return "", "", err
+	}
+	created := resp.(createGameResponse)
+	return created.GameID, created.HostSecret, nil
+}
+
+func (c httpClient) AuthorizeHost(ctx context.Context, gameID game.ID, secret string) error {
+	_, err := c.authorizeHost(ctx, authorizeHostRequest{GameID: gameID, Secret: secret})
+	return err
+}
+
+func (c httpClient) CreateTicket(ctx context.Context, gameID game.ID, username string) (ticket.ID, error) {
+	resp, err := c.createTicket(ctx, createTicketRequest{GameID: gameID, Username: username})
+	if err != nil {
+		return "", err
+	}
+	return resp.(createTicketResponse).TicketID, nil
+}
+
+func (c httpClient) CreateTicketStrip(ctx context.Context, gameID game.ID, username string) (ticket.StripID, []ticket.ID, error) {
+	resp, err := c.createTicketStrip(ctx, createTicketStripRequest{GameID: gameID, Username: username})
+	if err != nil {
+		return "", nil, err
+	}
+	created := resp.(createTicketStripResponse)
+	return created.StripID, created.TicketIDs, nil
+}
+
+func (c httpClient) ShowTicket(ctx context.Context, id ticket.ID) (Ticket, error) {
+	resp, err := c.showTicket(ctx, showTicketRequest{TicketID: id})
+	if err != nil {
+		return Ticket{}, err
+	}
+	return resp.(showTicketResponse).Ticket, nil
+}
+
+func (c httpClient) ListTickets(ctx context.Context, gameID game.ID, offset, limit int) ([]Ticket, int, error) {
+	resp, err := c.listTickets(ctx, listTicketsRequest{GameID: gameID, Offset: offset, Limit: limit})
+	if err != nil {
+		return nil, 0, err
+	}
+	listed := resp.(listTicketsResponse)
+	ts := make([]Ticket, len(listed.Tickets))
+	for i, l := range listed.Tickets {
+		ts[i] = Ticket{
+			ID:        l.TicketID,
+			GameID:    gameID,
+			StripID:   l.StripID,
+			Username:  l.Username,
+			Rows:      l.Rows,
+			Cols:      l.Cols,
+			Cells:     l.Cells,
+			Marked:    l.Marked,
+			AutoDaub:  l.AutoDaub,
+			CreatedAt: l.CreatedAt,
+		}
+	}
+	return ts, listed.Total, nil
+}
+
+func (c httpClient) MarkNumber(ctx context.Context, id ticket.ID, number int64) error {
+	_, err := c.markNumber(ctx, markNumberRequest{TicketID: id, Number: number, Marked: true})
+	return err
+}
+
+func (c httpClient) UnmarkNumber(ctx context.Context, id ticket.ID, number int64) error {
+	_, err := c.markNumber(ctx, markNumberRequest{TicketID: id, Number: number})
+	return err
+}
+
+func (c httpClient) SetAutoDaub(ctx context.Context, id ticket.ID, enabled bool) error {
+	_, err := c.setAutoDaub(ctx, setAutoDaubRequest{TicketID: id, Enabled: enabled})
+	return err
+}
+
+func (c httpClient) GenerateNumber(ctx context.Context, gameID game.ID, locale string) (Draw, error) {
+	resp, err := c.generateNumber(ctx, generateNumberRequest{GameID: gameID, Locale: locale})
+	if err != nil {
+		return Draw{}, err
+	}
+	drawn := resp.(generateNumberResponse)
+	return Draw{Seq: drawn.Seq, Number: drawn.Number, DrawnAt: drawn.DrawnAt, Caller: drawn.Caller, Announcement: drawn.Announcement}, nil
+}
+
+func (c httpClient) GetDraws(ctx context.Context, gameID game.ID, since int, locale string) ([]Draw, error) {
+	resp, err := c.getDraws(ctx, getDrawsRequest{GameID: gameID, Since: since, Locale: locale})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(getDrawsResponse).Draws, nil
+}
+
+func (c httpClient) SetCalls(ctx context.Context, gameID game.ID, calls game.Calls) error {
+	_, err := c.setCalls(ctx, setCallsRequest{GameID: gameID, Calls: calls})
+	return err
+}
+
+func (c httpClient) GetStats(ctx context.Context, gameID game.ID) (Stats, error) {
+	resp, err := c.getStats(ctx, getStatsRequest{GameID: gameID})
+	if err != nil {
+		return Stats{}, err
+	}
+	stats := resp.(getStatsResponse).Stats
+	if stats == nil {
+		return Stats{}, nil
+	}
+	return *stats, nil
+}
+
+func (c httpClient) ClaimPrize(ctx context.Context, gameID game.ID, id ticket.ID, p game.Prize) error {
+	_, err := c.claimPrize(ctx, claimPrizeRequest{GameID: gameID, TicketID: id, Prize: p})
+	return err
+}
+
+// Watch streams events of a game as Server-Sent Events, until ctx is done or the server ends the stream.
+func (c httpClient) Watch(ctx context.Context, gameID game.ID, lastEventID int64) (<-chan Event, error) {
+	resp, err := c.watch(ctx, watchRequest{GameID: gameID, LastEventID: lastEventID})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(<-chan Event), nil
+}
+
+func (c httpClient) StartGame(ctx context.Context, gameID game.ID) error {
+	_, err := c.startGame(ctx, changeStatusRequest{GameID: gameID})
+	return err
+}
+
+func (c httpClient) PauseGame(ctx context.Context, gameID game.ID) error {
+	_, err := c.pauseGame(ctx, changeStatusRequest{GameID: gameID})
+	return err
+}
+
+func (c httpClient) ResumeGame(ctx context.Context, gameID game.ID) error {
+	_, err := c.resumeGame(ctx, changeStatusRequest{GameID: gameID})
+	return err
+}
+
+func (c httpClient) EndGame(ctx context.Context, gameID game.ID) error {
+	_, err := c.endGame(ctx, changeStatusRequest{GameID: gameID})
+	return err
+}
+
+func (c httpClient) GetSeed(ctx context.Context, gameID game.ID) (string, string, error) {
+	resp, err := c.getSeed(ctx, getSeedRequest{GameID: gameID})
+	if err != nil {
+		return "", "", err
+	}
+	seed := resp.(getSeedResponse)
+	if seed.SeedHash != "" && seed.Seed == "" {
+		return seed.SeedHash, "", ErrSeedNotRevealed
+	}
+	return seed.SeedHash, seed.Seed, nil
+}
+
+func (c httpClient) StartAutoCall(ctx context.Context, gameID game.ID, interval time.Duration) error {
+	_, err := c.autoCall(ctx, autoCallRequest{GameID: gameID, Enabled: true, Interval: interval})
+	return err
+}
+
+func (c httpClient) StopAutoCall(ctx context.Context, gameID game.ID) error {
+	_, err := c.autoCall(ctx, autoCallRequest{GameID: gameID})
+	return err
+}
+
+// gamePath returns the path of an API of a game.
+func gamePath(gameID game.ID, path string) string {
+	return "/api/game/" + url.PathEscape(string(gameID)) + path
+}
+
+// ticketPath returns the path of an API of a ticket.
+func ticketPath(id ticket.ID, path string) string {
+	return "/api/ticket/" + url.PathEscape(string(id)) + path
+}
+
+// encodeJSONBody sends body as the JSON body of a request.
+func encodeJSONBody(r *http.Request, body interface{}) error {
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(body); err != nil {
+		return err
+	}
+	r.Header.Set("Content-Type", "application/json; charset=utf-8")
+	r.ContentLength = int64(buf.Len())
+	r.Body = ioutil.NopCloser(&buf)
+	return nil
+}
+
+func encodeCreateGameRequest(_ context.Context, r *http.Request, request interface{}) error {
+	req := request.(createGameRequest)
+	r.URL.Path = "/api/game/create"
+	return encodeJSONBody(r, map[string]interface{}{
+		"format":               req.Format.Name,
+		"rows":                 req.Format.Rows,
+		"cols":                 req.Format.Cols,
+		"min":                  req.Format.Min,
+		"max":                  req.Format.Max,
+		"numbers":              req.Format.Numbers,
+		"max_tickets_per_user": req.Rules.MaxTicketsPerUser,
+		"max_tickets":          req.Rules.MaxTickets,
+		"sales_cutoff":         req.Rules.SalesCutoff,
+	})
+}
+
+func encodeAuthorizeHostRequest(_ context.Context, r *http.Request, request interface{}) error {
+	req := request.(authorizeHostRequest)
+	r.URL.Path = gamePath(req.GameID, "/host")
+	r.Header.Set(HostSecretHeader, req.Secret)
+	return nil
+}
+
+func encodeCreateTicketRequest(_ context.Context, r *http.Request, request interface{}) error {
+	req := request.(createTicketRequest)
+	r.URL.Path = gamePath(req.GameID, "/ticket/"+url.PathEscape(req.Username)+"/generate")
+	return nil
+}
+
+func encodeCreateTicketStripRequest(_ context.Context, r *http.Request, request interface{}) error {
+	req := request.(createTicketStripRequest)
+	r.URL.Path = gamePath(req.GameID, "/strip/"+url.PathEscape(req.Username)+"/generate")
+	return nil
+}
+
+func encodeShowTicketRequest(_ context.Context, r *http.Request, request interface{}) error {
+	req := request.(showTicketRequest)
+	r.URL.Path = ticketPath(req.TicketID, "")
+	return nil
+}
+
+func encodeListTicketsRequest(_ context.Context, r *http.Request, request interface{}) error {
+	req := request.(listTicketsRequest)
+	r.URL.Path = gamePath(req.GameID, "/tickets")
+	q := url.Values{}
+	q.Set("offset", strconv.Itoa(req.Offset))
+	if req.Limit > 0 {
+		q.Set("limit", strconv.Itoa(req.Limit))
+	}
+	r.URL.RawQuery = q.Encode()
+	return nil
+}
+
+func encodeMarkNumberRequest(_ context.Context, r *http.Request, request interface{}) error {
+	req := request.(markNumberRequest)
+	action := "/unmark/"
+	if req.Marked {
+		action = "/mark/"
+	}
+	r.URL.Path = ticketPath(req.TicketID, action+strconv.FormatInt(req.Number, 10))
+	return nil
+}
+
+func encodeSetAutoDaubRequest(_ context.Context, r *http.Request, request interface{}) error {
+	req := request.(setAutoDaubRequest)
+	r.URL.Path = ticketPath(req.TicketID, "/autodaub")
+	return encodeJSONBody(r, map[string]bool{"enabled": req.Enabled})
+}
+
+func encodeGenerateNumberRequest(_ context.Context, r *http.Request, request interface{}) error {
+	req := request.(generateNumberRequest)
+	r.URL.Path = gamePath(req.GameID, "/number/random")
+	if req.Locale != "" {
+		r.URL.RawQuery = url.Values{"locale": {req.Locale}}.Encode()
+	}
+	return nil
+}
+
+func encodeGetDrawsRequest(_ context.Context, r *http.Request, request interface{}) error {
+	req := request.(getDrawsRequest)
+	r.URL.Path = gamePath(req.GameID, "/numbers")
+	q := url.Values{}
+	if req.Since > 0 {
+		q.Set("since", strconv.Itoa(req.Since))
+	}
+	if req.Locale != "" {
+		q.Set("locale", req.Locale)
+	}
+	r.URL.RawQuery = q.Encode()
+	return nil
+}
+
+func encodeSetCallsRequest(_ context.Context, r *http.Request, request interface{}) error {
+	req := request.(setCallsRequest)
+	r.URL.Path = gamePath(req.GameID, "/calls")
+	names := make(map[string]string, len(req.Calls.Custom))
+	for n, name := range req.Calls.Custom {
+		names[strconv.FormatInt(n, 10)] = name
+	}
+	return encodeJSONBody(r, map[string]interface{}{"locale": req.Calls.Locale, "names": names})
+}
+
+func encodeGetStatsRequest(_ context.Context, r *http.Request, request interface{}) error {
+	req := request.(getStatsRequest)
+	r.URL.Path = gamePath(req.GameID, "/stats")
+	return nil
+}
+
+func encodeClaimPrizeRequest(_ context.Context, r *http.Request, request interface{}) error {
+	req := request.(claimPrizeRequest)
+	r.URL.Path = gamePath(req.GameID, "/ticket/"+url.PathEscape(string(req.TicketID))+"/claim")
+	return encodeJSONBody(r, map[string]game.Prize{"prize": req.Prize})
+}
+
+type watchRequest struct {
+	GameID      game.ID
+	LastEventID int64
+}
+
+func encodeWatchRequest(_ context.Context, r *http.Request, request interface{}) error {
+	req := request.(watchRequest)
+	r.URL.Path = gamePath(req.GameID, "/events")
+	if req.LastEventID > 0 {
+		r.Header.Set("Last-Event-ID", strconv.FormatInt(req.LastEventID, 10))
+	}
+	r.Header.Set("Accept", "text/event-stream")
+	return nil
+}
+
+// encodeChangeStatusRequest returns an encoder for requests which move a game on with action, such as "start".
+func encodeChangeStatusRequest(action string) kithttp.EncodeRequestFunc {
+	return func(_ context.Context, r *http.Request, request interface{}) error {
+		req := request.(changeStatusRequest)
+		r.URL.Path = gamePath(req.GameID, "/"+action)
+		return nil
+	}
+}
+
+func encodeGetSeedRequest(_ context.Context, r *http.Request, request interface{}) error {
+	req := request.(getSeedRequest)
+	r.URL.Path = gamePath(req.GameID, "/seed")
+	return nil
+}
+
+func encodeAutoCallRequest(_ context.Context, r *http.Request, request interface{}) error {
+	req := request.(autoCallRequest)
+	if !req.Enabled {
+		r.URL.Path = gamePath(req.GameID, "/autocall/stop")
+		return nil
+	}
+	r.URL.Path = gamePath(req.GameID, "/autocall/start")
+	return encodeJSONBody(r, map[string]string{"interval": req.Interval.String()})
+}
+
+// decodeJSONResponse decodes the JSON body of a successful response into v, and errors of the API otherwise.
+func decodeJSONResponse(r *http.Response, v interface{}) error {
+	if r.StatusCode != http.StatusOK {
+		return decodeError(r)
+	}
+	return json.NewDecoder(r.Body).Decode(v)
+}
+
+func decodeCreateGameResponse(_ context.Context, r *http.Response) (interface{}, error) {
+	var resp createGameResponse
+	err := decodeJSONResponse(r, &resp)
+	return resp, err
+}
+
+func decodeAuthorizeHostResponse(_ context.Context, r *http.Response) (interface{}, error) {
+	if r.StatusCode != http.StatusOK {
+		return nil, decodeError(r)
+	}
+	return authorizeHostResponse{}, nil
+}
+
+func decodeCreateTicketResponse(_ context.Context, r *http.Response) (interface{}, error) {
+	var resp createTicketResponse
+	err := decodeJSONResponse(r, &resp)
+	return resp, err
+}
+
+func decodeCreateTicketStripResponse(_ context.Context, r *http.Response) (interface{}, error) {
+	var resp createTicketStripResponse
+	err := decodeJSONResponse(r, &resp)
+	return resp, err
+}
+
+// decodeShowTicketResponse rebuilds a ticket from its cells, as served by encodeTicketResponse.
+func decodeShowTicketResponse(_ context.Context, r *http.Response) (interface{}, error) {
+	var details ticketDetails
+	if err := decodeJSONResponse(r, &details); err != nil {
+		return nil, err
+	}
+	t := Ticket{
+		ID:        details.ID,
+		GameID:    details.GameID,
+		StripID:   details.StripID,
+		Username:  details.Username,
+		AutoDaub:  details.AutoDaub,
+		Rows:      len(details.Rows),
+		CreatedAt: details.CreatedAt,
+		Format:    details.Format,
+		Nonce:     details.Nonce,
+	}
+	for _, row := range details.Rows {
+		t.Cols = len(row)
+		for _, cell := range row {
+			switch {
+			case cell.Free:
+				t.Cells = append(t.Cells, ticket.FreeCell)
+			case cell.Number == nil:
+				t.Cells = append(t.Cells, ticket.EmptyCell)
+			default:
+				t.Cells = append(t.Cells, *cell.Number)
+				if cell.Drawn {
+					t.Drawn = append(t.Drawn, *cell.Number)
+				}
+				if cell.Marked {
+					t.Marked = append(t.Marked, *cell.Number)
+				}
+			}
+		}
+	}
+	return showTicketResponse{Ticket: t}, nil
+}
+
+func decodeListTicketsResponse(_ context.Context, r *http.Response) (interface{}, error) {
+	var resp listTicketsResponse
+	err := decodeJSONResponse(r, &resp)
+	return resp, err
+}
+
+func decodeMarkNumberResponse(_ context.Context, r *http.Response) (interface{}, error) {
+	var resp markNumberResponse
+	err := decodeJSONResponse(r, &resp)
+	return resp, err
+}
+
+func decodeSetAutoDaubResponse(_ context.Context, r *http.Response) (interface{}, error) {
+	var resp setAutoDaubResponse
+	err := decodeJSONResponse(r, &resp)
+	return resp, err
+}
+
+func decodeGenerateNumberResponse(_ context.Context, r *http.Response) (interface{}, error) {
+	var resp generateNumberResponse
+	err := decodeJSONResponse(r, &resp)
+	return resp, err
+}
+
+func decodeGetDrawsResponse(_ context.Context, r *http.Response) (interface{}, error) {
+	var resp getDrawsResponse
+	err := decodeJSONResponse(r, &resp)
+	return resp, err
+}
+
+func decodeSetCallsResponse(_ context.Context, r *http.Response) (interface{}, error) {
+	var resp setCallsResponse
+	err := decodeJSONResponse(r, &resp)
+	return resp, err
+}
+
+func decodeGetStatsResponse(_ context.Context, r *http.Response) (interface{}, error) {
+	var resp getStatsResponse
+	err := decodeJSONResponse(r, &resp)
+	return resp, err
+}
+
+func decodeClaimPrizeResponse(_ context.Context, r *http.Response) (interface{}, error) {
+	var resp claimPrizeResponse
+	err := decodeJSONResponse(r, &resp)
+	return resp, err
+}
+
+// decodeWatchResponse reads events from a Server-Sent Events stream, until the stream ends or ctx is done.
+func decodeWatchResponse(ctx context.Context, r *http.Response) (interface{}, error) {
+	if r.StatusCode != http.StatusOK {
+		defer r.Body.Close()
+		return nil, decodeError(r)
+	}
+	events := make(chan Event)
+	go func() {
+		defer close(events)
+		defer r.Body.Close()
+		scanner := bufio.NewScanner(r.Body)
+		for scanner.Scan() {
+			data := scanner.Text()
+			if !strings.HasPrefix(data, "data: ") {
+				continue
+			}
+			var e Event
+			if err := json.Unmarshal([]byte(strings.TrimPrefix(data, "data: ")), &e); err != nil {
+				return
+			}
+			select {
+			case events <- e:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return (<-chan Event)(events), nil
+}
+
+func decodeChangeStatusResponse(_ context.Context, r *http.Response) (interface{}, error) {
+	var resp changeStatusResponse
+	err := decodeJSONResponse(r, &resp)
+	return resp, err
+}
+
+func decodeGetSeedResponse(_ context.Context, r *http.Response) (interface{}, error) {
+	var resp getSeedResponse
+	err := decodeJSONResponse(r, &resp)
+	return resp, err
+}
+
+func decodeAutoCallResponse(_ context.Context, r *http.Response) (interface{}, error) {
+	var resp autoCallResponse
+	err := decodeJSONResponse(r, &resp)
+	return resp, err
+}
+
+// decodeError translates the problem details of a response, as encoded by errs.EncodeError, back into the error
+// they were encoded from, so callers can compare errors of a remote service with those of a local one.
+func decodeError(r *http.Response) error {
+	var p errs.Problem
+	if err := json.NewDecoder(r.Body).Decode(&p); err != nil || p.Code == "" {
+		return fmt.Errorf("bingo: unexpected response status %v", r.Status)
+	}
+	if e, ok := errs.Lookup(p.Code); ok {
+		if len(p.InvalidParams) > 0 {
+			return e.WithFields(p.InvalidParams...)
+		}
+		return e
+	}
+	return &errs.Error{Code: p.Code, Status: p.Status, Message: p.Detail, Fields: p.InvalidParams}
+}
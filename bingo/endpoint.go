@@ -2,26 +2,49 @@ package bingo
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"github.com/divyanshgaba/bingo/game"
 	"github.com/divyanshgaba/bingo/ticket"
 	"github.com/go-kit/kit/endpoint"
 )
 
-type createGameRequest struct{}
+type createGameRequest struct {
+	Format game.Format
+	Rules  game.Rules
+}
 
 type createGameResponse struct {
-	GameID game.ID `json:"game_id,omitempty"`
-	Err    error   `json:"error,omitempty"`
+	GameID     game.ID `json:"game_id,omitempty"`
+	HostSecret string  `json:"host_secret,omitempty"`
+	Err        error   `json:"error,omitempty"`
 }
 
 func (r createGameResponse) error() error { return r.Err }
 
 func makeCreateGameEndpoint(s Service) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
-		_ = request.(createGameRequest)
-		id, err := s.CreateGame(ctx)
-		return createGameResponse{GameID: id, Err: err}, nil
+		req := request.(createGameRequest)
+		id, secret, err := s.CreateGame(ctx, req.Format, req.Rules)
+		return createGameResponse{GameID: id, HostSecret: secret, Err: err}, nil
+	}
+}
+
+type authorizeHostRequest struct {
+	GameID game.ID
+	Secret string
+}
+type authorizeHostResponse struct {
+	Err error `json:"error,omitempty"`
+}
+
+func (r authorizeHostResponse) error() error { return r.Err }
+
+func makeAuthorizeHostEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(authorizeHostRequest)
+		return authorizeHostResponse{Err: s.AuthorizeHost(ctx, req.GameID, req.Secret)}, nil
 	}
 }
 
@@ -44,12 +67,40 @@ func makeCreateTicketEndpoint(s Service) endpoint.Endpoint {
 	}
 }
 
+type createTicketStripRequest struct {
+	GameID   game.ID
+	Username string
+}
+type createTicketStripResponse struct {
+	StripID   ticket.StripID `json:"strip_id,omitempty"`
+	TicketIDs []ticket.ID    `json:"ticket_ids,omitempty"`
+	Err       error          `json:"error,omitempty"`
+}
+
+func (r createTicketStripResponse) error() error { return r.Err }
+
+func makeCreateTicketStripEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(createTicketStripRequest)
+		stripID, ids, err := s.CreateTicketStrip(ctx, req.GameID, req.Username)
+		return createTicketStripResponse{StripID: stripID, TicketIDs: ids, Err: err}, nil
+	}
+}
+
 type generateNumberRequest struct {
 	GameID game.ID
+	Locale string
 }
+
+func (r generateNumberRequest) gameID() game.ID { return r.GameID }
+
 type generateNumberResponse struct {
-	Number int64 `json:"number,omitempty"`
-	Err    error `json:"error,omitempty"`
+	Number       int64      `json:"number,omitempty"`
+	Seq          int        `json:"seq,omitempty"`
+	DrawnAt      *time.Time `json:"drawn_at,omitempty"`
+	Caller       string     `json:"caller,omitempty"`
+	Announcement string     `json:"announcement,omitempty"`
+	Err          error      `json:"error,omitempty"`
 }
 
 func (r generateNumberResponse) error() error { return r.Err }
@@ -57,8 +108,11 @@ func (r generateNumberResponse) error() error { return r.Err }
 func makeGenerateNumberEndpoint(s Service) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(generateNumberRequest)
-		num, err := s.GenerateNumber(ctx, req.GameID)
-		return generateNumberResponse{Number: num, Err: err}, nil
+		d, err := s.GenerateNumber(ctx, req.GameID, req.Locale)
+		if err != nil {
+			return generateNumberResponse{Err: err}, nil
+		}
+		return generateNumberResponse{Number: d.Number, Seq: d.Seq, DrawnAt: d.DrawnAt, Caller: d.Caller, Announcement: d.Announcement}, nil
 	}
 }
 
@@ -81,21 +135,107 @@ func makeShowTicketEndpoint(s Service) endpoint.Endpoint {
 	}
 }
 
-type getAllNumbersRequest struct {
+type listTicketsRequest struct {
+	GameID        game.ID
+	Offset, Limit int
+}
+
+type listTicketsResponse struct {
+	Tickets []ticketListing `json:"tickets"`
+	Total   int             `json:"total"`
+	Offset  int             `json:"offset"`
+	Err     error           `json:"error,omitempty"`
+}
+
+type ticketListing struct {
+	TicketID  ticket.ID      `json:"ticket_id"`
+	Username  string         `json:"username"`
+	StripID   ticket.StripID `json:"strip_id,omitempty"`
+	Rows      int            `json:"rows"`
+	Cols      int            `json:"cols"`
+	Cells     []int64        `json:"cells"`
+	Marked    []int64        `json:"marked,omitempty"`
+	AutoDaub  bool           `json:"auto_daub"`
+	CreatedAt time.Time      `json:"created_at"`
+}
+
+func (r listTicketsResponse) error() error { return r.Err }
+
+func makeListTicketsEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(listTicketsRequest)
+		ts, total, err := s.ListTickets(ctx, req.GameID, req.Offset, req.Limit)
+		if err != nil {
+			return listTicketsResponse{Err: err}, nil
+		}
+		listings := make([]ticketListing, len(ts))
+		for i, t := range ts {
+			listings[i] = ticketListing{
+				TicketID:  t.ID,
+				Username:  t.Username,
+				StripID:   t.StripID,
+				Rows:      t.Rows,
+				Cols:      t.Cols,
+				Cells:     t.Cells,
+				Marked:    t.Marked,
+				AutoDaub:  t.AutoDaub,
+				CreatedAt: t.CreatedAt,
+			}
+		}
+		return listTicketsResponse{Tickets: listings, Total: total, Offset: req.Offset}, nil
+	}
+}
+
+type getDrawsRequest struct {
 	GameID game.ID
+	Since  int
+	Locale string
 }
-type getAllNumbersResponse struct {
+type getDrawsResponse struct {
 	Numbers []int64 `json:"numbers,omitempty"`
+	Draws   []Draw  `json:"draws,omitempty"`
 	Err     error   `json:"error,omitempty"`
 }
 
-func (r getAllNumbersResponse) error() error { return r.Err }
+func (r getDrawsResponse) error() error { return r.Err }
+
+func makeGetDrawsEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(getDrawsRequest)
+		draws, err := s.GetDraws(ctx, req.GameID, req.Since, req.Locale)
+		if err != nil {
+			return getDrawsResponse{Err: err}, nil
+		}
+		numbers := make([]int64, len(draws))
+		for i, d := range draws {
+			numbers[i] = d.Number
+		}
+		return getDrawsResponse{Numbers: numbers, Draws: draws}, nil
+	}
+}
+
+type setCallsRequest struct {
+	GameID game.ID
+	Calls  game.Calls
+}
+
+func (r setCallsRequest) gameID() game.ID { return r.GameID }
+
+type setCallsResponse struct {
+	Locale string `json:"locale,omitempty"`
+	Err    error  `json:"error,omitempty"`
+}
+
+func (r setCallsResponse) error() error { return r.Err }
 
-func makeGetAllNumbersEndpoint(s Service) endpoint.Endpoint {
+func makeSetCallsEndpoint(s Service) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
-		req := request.(getAllNumbersRequest)
-		numbers, err := s.GetAllNumbers(ctx, req.GameID)
-		return getAllNumbersResponse{Numbers: numbers, Err: err}, nil
+		req := request.(setCallsRequest)
+		err := s.SetCalls(ctx, req.GameID, req.Calls)
+		if err != nil {
+			return setCallsResponse{Err: err}, nil
+		}
+		return setCallsResponse{Locale: req.Calls.Locale}, nil
 	}
 }
 
@@ -103,9 +243,8 @@ type getStatsRequest struct {
 	GameID game.ID
 }
 type getStatsResponse struct {
-	NumbersDrawn     int   `json:"numbers_drawn,omitempty"`
-	TicketsGenerated int   `json:"tickets_generated,omitempty"`
-	Err              error `json:"error,omitempty"`
+	*Stats
+	Err error `json:"error,omitempty"`
 }
 
 func (r getStatsResponse) error() error { return r.Err }
@@ -113,7 +252,199 @@ func (r getStatsResponse) error() error { return r.Err }
 func makeGetStatsEndpoint(s Service) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(getStatsRequest)
-		numbersDrawn, ticketsGenerated, err := s.GetStats(ctx, req.GameID)
-		return getStatsResponse{NumbersDrawn: numbersDrawn, TicketsGenerated: ticketsGenerated, Err: err}, nil
+		stats, err := s.GetStats(ctx, req.GameID)
+		if err != nil {
+			return getStatsResponse{Err: err}, nil
+		}
+		return getStatsResponse{Stats: &stats}, nil
+	}
+}
+
+type claimPrizeRequest struct {
+	GameID   game.ID
+	TicketID ticket.ID
+	Prize    game.Prize
+}
+type claimPrizeResponse struct {
+	Prize    game.Prize `json:"prize,omitempty"`
+	TicketID ticket.ID  `json:"ticket_id,omitempty"`
+	Err      error      `json:"error,omitempty"`
+}
+
+func (r claimPrizeResponse) error() error { return r.Err }
+
+func makeClaimPrizeEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(claimPrizeRequest)
+		err := s.ClaimPrize(ctx, req.GameID, req.TicketID, req.Prize)
+		if err != nil {
+			return claimPrizeResponse{Err: err}, nil
+		}
+		return claimPrizeResponse{Prize: req.Prize, TicketID: req.TicketID}, nil
+	}
+}
+
+type changeStatusRequest struct {
+	GameID game.ID
+}
+
+func (r changeStatusRequest) gameID() game.ID { return r.GameID }
+
+type changeStatusResponse struct {
+	Status game.Status `json:"status,omitempty"`
+	Err    error       `json:"error,omitempty"`
+}
+
+func (r changeStatusResponse) error() error { return r.Err }
+
+// makeChangeStatusEndpoint returns an endpoint which calls `change` to move a game to status `to`.
+func makeChangeStatusEndpoint(change func(context.Context, game.ID) error, to game.Status) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(changeStatusRequest)
+		err := change(ctx, req.GameID)
+		if err != nil {
+			return changeStatusResponse{Err: err}, nil
+		}
+		return changeStatusResponse{Status: to}, nil
+	}
+}
+
+func makeStartGameEndpoint(s Service) endpoint.Endpoint {
+	return makeChangeStatusEndpoint(s.StartGame, game.Running)
+}
+
+func makePauseGameEndpoint(s Service) endpoint.Endpoint {
+	return makeChangeStatusEndpoint(s.PauseGame, game.Paused)
+}
+
+func makeResumeGameEndpoint(s Service) endpoint.Endpoint {
+	return makeChangeStatusEndpoint(s.ResumeGame, game.Running)
+}
+
+func makeEndGameEndpoint(s Service) endpoint.Endpoint {
+	return makeChangeStatusEndpoint(s.EndGame, game.Finished)
+}
+
+type getSeedRequest struct {
+	GameID game.ID
+}
+type getSeedResponse struct {
+	SeedHash string `json:"seed_hash,omitempty"`
+	Seed     string `json:"seed,omitempty"`
+	Err      error  `json:"error,omitempty"`
+}
+
+func (r getSeedResponse) error() error { return r.Err }
+
+func makeGetSeedEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(getSeedRequest)
+		seedHash, seed, err := s.GetSeed(ctx, req.GameID)
+		if errors.Is(err, ErrSeedNotRevealed) {
+			err = nil // the hash is published before the seed is revealed
+		}
+		return getSeedResponse{SeedHash: seedHash, Seed: seed, Err: err}, nil
+	}
+}
+
+type autoCallRequest struct {
+	GameID   game.ID
+	Enabled  bool
+	Interval time.Duration
+}
+
+func (r autoCallRequest) gameID() game.ID { return r.GameID }
+
+type autoCallResponse struct {
+	Enabled  bool   `json:"enabled"`
+	Interval string `json:"interval,omitempty"`
+	Err      error  `json:"error,omitempty"`
+}
+
+func (r autoCallResponse) error() error { return r.Err }
+
+func makeAutoCallEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(autoCallRequest)
+		if !req.Enabled {
+			return autoCallResponse{Err: s.StopAutoCall(ctx, req.GameID)}, nil
+		}
+		err := s.StartAutoCall(ctx, req.GameID, req.Interval)
+		if err != nil {
+			return autoCallResponse{Err: err}, nil
+		}
+		return autoCallResponse{Enabled: true, Interval: req.Interval.String()}, nil
+	}
+}
+
+type markNumberRequest struct {
+	TicketID ticket.ID
+	Number   int64
+	Marked   bool
+}
+
+type markNumberResponse struct {
+	Number int64 `json:"number"`
+	Marked bool  `json:"marked"`
+	Err    error `json:"error,omitempty"`
+}
+
+func (r markNumberResponse) error() error { return r.Err }
+
+func makeMarkNumberEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(markNumberRequest)
+		var err error
+		if req.Marked {
+			err = s.MarkNumber(ctx, req.TicketID, req.Number)
+		} else {
+			err = s.UnmarkNumber(ctx, req.TicketID, req.Number)
+		}
+		if err != nil {
+			return markNumberResponse{Err: err}, nil
+		}
+		return markNumberResponse{Number: req.Number, Marked: req.Marked}, nil
+	}
+}
+
+type setAutoDaubRequest struct {
+	TicketID ticket.ID
+	Enabled  bool
+}
+
+type setAutoDaubResponse struct {
+	Enabled bool  `json:"enabled"`
+	Err     error `json:"error,omitempty"`
+}
+
+func (r setAutoDaubResponse) error() error { return r.Err }
+
+func makeSetAutoDaubEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(setAutoDaubRequest)
+		err := s.SetAutoDaub(ctx, req.TicketID, req.Enabled)
+		if err != nil {
+			return setAutoDaubResponse{Err: err}, nil
+		}
+		return setAutoDaubResponse{Enabled: req.Enabled}, nil
+	}
+}
+
+// hostRequest is a request for a host operation on a game.
+type hostRequest interface {
+	gameID() game.ID
+}
+
+// requireHost returns a middleware which only lets requests for host operations through with the host secret of
+// the game in the context, see hostSecretToContext.
+func requireHost(s Service) endpoint.Middleware {
+	return func(next endpoint.Endpoint) endpoint.Endpoint {
+		return func(ctx context.Context, request interface{}) (interface{}, error) {
+			secret, _ := ctx.Value(hostSecretKey).(string)
+			if err := s.AuthorizeHost(ctx, request.(hostRequest).gameID(), secret); err != nil {
+				return nil, err
+			}
+			return next(ctx, request)
+		}
 	}
 }
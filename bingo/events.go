@@ -0,0 +1,89 @@
+package bingo
+
+import (
+	"sync"
+
+	"github.com/divyanshgaba/bingo/game"
+	"github.com/divyanshgaba/bingo/ticket"
+)
+
+// EventType identifies what happened in a game.
+type EventType string
+
+// types of events published for a game
+const (
+	EventNumberDrawn   EventType = "number_drawn"
+	EventTicketCreated EventType = "ticket_created"
+	EventGameFinished  EventType = "game_finished"
+)
+
+// Event is pushed to watchers of a game.
+// Only number drawn events have an ID, the sequence of that number, so watchers can resume
+// from the last ID they saw. Other events are not replayed and have no ID.
+type Event struct {
+	ID       int64     `json:"id,omitempty"`
+	Type     EventType `json:"type"`
+	GameID   game.ID   `json:"game_id"`
+	Number   *int64    `json:"number,omitempty"`
+	TicketID ticket.ID `json:"ticket_id,omitempty"`
+}
+
+func numberDrawnEvent(gameID game.ID, seq int, number int64) Event {
+	return Event{ID: int64(seq), Type: EventNumberDrawn, GameID: gameID, Number: &number}
+}
+
+// size of buffer for each subscriber, subscribers falling further behind are dropped.
+const subscriberBuffer = 32
+
+// hub fans out events of a game to its subscribers.
+type hub struct {
+	mtx  sync.Mutex
+	subs map[game.ID]map[chan Event]struct{}
+}
+
+func newHub() *hub {
+	return &hub{subs: make(map[game.ID]map[chan Event]struct{})}
+}
+
+func (h *hub) subscribe(gameID game.ID) chan Event {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+	ch := make(chan Event, subscriberBuffer)
+	if h.subs[gameID] == nil {
+		h.subs[gameID] = make(map[chan Event]struct{})
+	}
+	h.subs[gameID][ch] = struct{}{}
+	return ch
+}
+
+func (h *hub) unsubscribe(gameID game.ID, ch chan Event) {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+	h.remove(gameID, ch)
+}
+
+// remove must be called with mtx held.
+func (h *hub) remove(gameID game.ID, ch chan Event) {
+	if _, ok := h.subs[gameID][ch]; !ok {
+		return
+	}
+	delete(h.subs[gameID], ch)
+	if len(h.subs[gameID]) == 0 {
+		delete(h.subs, gameID)
+	}
+	close(ch)
+}
+
+// publish never blocks. A subscriber with a full buffer is closed, it can
+// watch again with the last event ID it received to catch up.
+func (h *hub) publish(e Event) {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+	for ch := range h.subs[e.GameID] {
+		select {
+		case ch <- e:
+		default:
+			h.remove(e.GameID, ch)
+		}
+	}
+}
@@ -0,0 +1,169 @@
+package bingo
+
+import (
+	"context"
+	"time"
+
+	"github.com/divyanshgaba/bingo/game"
+	"github.com/divyanshgaba/bingo/ticket"
+	"github.com/go-kit/kit/metrics"
+	"github.com/go-kit/kit/metrics/discard"
+)
+
+// Metrics count what happens in games, as recorded by a service made with WithMetrics. Numbers drawn
+// automatically are counted too, unlike requests to the service. Games which have not finished are counted by
+// the store, see game.Repository.CountActive, as games finish on every instance of the service.
+type Metrics struct {
+	GamesCreated  metrics.Counter
+	TicketsIssued metrics.Counter
+	NumbersDrawn  metrics.Counter
+}
+
+// WithMetrics records metrics of games in m.
+func WithMetrics(m Metrics) Option {
+	return func(s *service) {
+		s.metrics = m
+	}
+}
+
+// discardMetrics is what a service records when made without WithMetrics.
+func discardMetrics() Metrics {
+	return Metrics{
+		GamesCreated:  discard.NewCounter(),
+		TicketsIssued: discard.NewCounter(),
+		NumbersDrawn:  discard.NewCounter(),
+	}
+}
+
+type instrumentingService struct {
+	requestCount   metrics.Counter
+	requestLatency metrics.Histogram
+	next           Service
+}
+
+// NewInstrumentingService returns a Service which counts requests to s in counter and records their latency in
+// seconds in latency, both labelled by method.
+func NewInstrumentingService(counter metrics.Counter, latency metrics.Histogram, s Service) Service {
+	return &instrumentingService{
+		requestCount:   counter,
+		requestLatency: latency,
+		next:           s,
+	}
+}
+
+// observe records a request to method which began at begin.
+func (s *instrumentingService) observe(method string, begin time.Time) {
+	s.requestCount.With("method", method).Add(1)
+	s.requestLatency.With("method", method).Observe(time.Since(begin).Seconds())
+}
+
+func (s *instrumentingService) CreateGame(ctx context.Context, f game.Format, r game.Rules) (game.ID, string, error) {
+	defer s.observe("create_game", time.Now())
+	return s.next.CreateGame(ctx, f, r)
+}
+
+func (s *instrumentingService) AuthorizeHost(ctx context.Context, gameID game.ID, secret string) error {
+	defer s.observe("authorize_host", time.Now())
+	return s.next.AuthorizeHost(ctx, gameID, secret)
+}
+
+func (s *instrumentingService) CreateTicket(ctx context.Context, gameID game.ID, username string) (ticket.ID, error) {
+	defer s.observe("create_ticket", time.Now())
+	return s.next.CreateTicket(ctx, gameID, username)
+}
+
+func (s *instrumentingService) CreateTicketStrip(ctx context.Context, gameID game.ID, username string) (ticket.StripID, []ticket.ID, error) {
+	defer s.observe("create_ticket_strip", time.Now())
+	return s.next.CreateTicketStrip(ctx, gameID, username)
+}
+
+func (s *instrumentingService) ShowTicket(ctx context.Context, id ticket.ID) (Ticket, error) {
+	defer s.observe("show_ticket", time.Now())
+	return s.next.ShowTicket(ctx, id)
+}
+
+func (s *instrumentingService) ListTickets(ctx context.Context, gameID game.ID, offset, limit int) ([]Ticket, int, error) {
+	defer s.observe("list_tickets", time.Now())
+	return s.next.ListTickets(ctx, gameID, offset, limit)
+}
+
+func (s *instrumentingService) MarkNumber(ctx context.Context, id ticket.ID, number int64) error {
+	defer s.observe("mark_number", time.Now())
+	return s.next.MarkNumber(ctx, id, number)
+}
+
+func (s *instrumentingService) UnmarkNumber(ctx context.Context, id ticket.ID, number int64) error {
+	defer s.observe("unmark_number", time.Now())
+	return s.next.UnmarkNumber(ctx, id, number)
+}
+
+func (s *instrumentingService) SetAutoDaub(ctx context.Context, id ticket.ID, enabled bool) error {
+	defer s.observe("set_auto_daub", time.Now())
+	return s.next.SetAutoDaub(ctx, id, enabled)
+}
+
+func (s *instrumentingService) GenerateNumber(ctx context.Context, gameID game.ID, locale string) (Draw, error) {
+	defer s.observe("generate_number", time.Now())
+	return s.next.GenerateNumber(ctx, gameID, locale)
+}
+
+func (s *instrumentingService) GetDraws(ctx context.Context, gameID game.ID, since int, locale string) ([]Draw, error) {
+	defer s.observe("get_draws", time.Now())
+	return s.next.GetDraws(ctx, gameID, since, locale)
+}
+
+func (s *instrumentingService) SetCalls(ctx context.Context, gameID game.ID, c game.Calls) error {
+	defer s.observe("set_calls", time.Now())
+	return s.next.SetCalls(ctx, gameID, c)
+}
+
+func (s *instrumentingService) GetStats(ctx context.Context, gameID game.ID) (Stats, error) {
+	defer s.observe("get_stats", time.Now())
+	return s.next.GetStats(ctx, gameID)
+}
+
+func (s *instrumentingService) ClaimPrize(ctx context.Context, gameID game.ID, ticketID ticket.ID, prize game.Prize) error {
+	defer s.observe("claim_prize", time.Now())
+	return s.next.ClaimPrize(ctx, gameID, ticketID, prize)
+}
+
+// Watch records the latency of subscribing to events of a game, not how long they are watched.
+func (s *instrumentingService) Watch(ctx context.Context, gameID game.ID, lastEventID int64) (<-chan Event, error) {
+	defer s.observe("watch", time.Now())
+	return s.next.Watch(ctx, gameID, lastEventID)
+}
+
+func (s *instrumentingService) StartGame(ctx context.Context, gameID game.ID) error {
+	defer s.observe("start_game", time.Now())
+	return s.next.StartGame(ctx, gameID)
+}
+
+func (s *instrumentingService) PauseGame(ctx context.Context, gameID game.ID) error {
+	defer s.observe("pause_game", time.Now())
+	return s.next.PauseGame(ctx, gameID)
+}
+
+func (s *instrumentingService) ResumeGame(ctx context.Context, gameID game.ID) error {
+	defer s.observe("resume_game", time.Now())
+	return s.next.ResumeGame(ctx, gameID)
+}
+
+func (s *instrumentingService) EndGame(ctx context.Context, gameID game.ID) error {
+	defer s.observe("end_game", time.Now())
+	return s.next.EndGame(ctx, gameID)
+}
+
+func (s *instrumentingService) GetSeed(ctx context.Context, gameID game.ID) (string, string, error) {
+	defer s.observe("get_seed", time.Now())
+	return s.next.GetSeed(ctx, gameID)
+}
+
+func (s *instrumentingService) StartAutoCall(ctx context.Context, gameID game.ID, interval time.Duration) error {
+	defer s.observe("start_auto_call", time.Now())
+	return s.next.StartAutoCall(ctx, gameID, interval)
+}
+
+func (s *instrumentingService) StopAutoCall(ctx context.Context, gameID game.ID) error {
+	defer s.observe("stop_auto_call", time.Now())
+	return s.next.StopAutoCall(ctx, gameID)
+}
@@ -0,0 +1,262 @@
+package bingo
+
+import (
+	"math/rand"
+	"sort"
+
+	"github.com/divyanshgaba/bingo/game"
+	"github.com/divyanshgaba/bingo/ticket"
+)
+
+// Layout generates tickets for a game format.
+type Layout interface {
+	// Generate returns cells of a new ticket row by row, -1 for cells without a number.
+	Generate(game.Format, *rand.Rand) []int
+}
+
+// StripLayout is a Layout which can also generate strips, tickets sold together
+// which cover every number of the format exactly once.
+type StripLayout interface {
+	Layout
+	GenerateStrip(game.Format, *rand.Rand) [][]int
+}
+
+// layouts by name of the format they generate tickets for.
+var layouts = map[string]Layout{
+	game.FormatClassic: gridLayout{},
+	game.FormatCustom:  gridLayout{},
+	game.Format90Ball:  ninetyBallLayout{},
+	game.Format75Ball:  seventyFiveBallLayout{},
+}
+
+// limits for custom formats
+const (
+	maxCustomCells = 100
+	maxCustomBalls = 1000
+)
+
+// resolveFormat returns the format a game is created with, presets are looked up by name.
+func resolveFormat(f game.Format) (game.Format, error) {
+	switch f.Name {
+	case "", game.FormatClassic:
+		return game.Classic, nil
+	case game.Format90Ball:
+		return game.NinetyBall, nil
+	case game.Format75Ball:
+		return game.SeventyFiveBall, nil
+	case game.FormatCustom:
+		if f.Rows < 1 || f.Cols < 1 || f.Rows*f.Cols > maxCustomCells {
+			return game.Format{}, ErrInvalidArgument
+		}
+		if f.Min < 0 || f.Max < f.Min || f.Balls() > maxCustomBalls {
+			return game.Format{}, ErrInvalidArgument
+		}
+		if f.Numbers < 1 || f.Numbers > f.Rows*f.Cols || f.Numbers > f.Balls() {
+			return game.Format{}, ErrInvalidArgument
+		}
+		return f, nil
+	default:
+		return game.Format{}, ErrInvalidArgument
+	}
+}
+
+// gridLayout spreads numbers picked from the whole range evenly across rows, at random columns.
+type gridLayout struct{}
+
+func (gridLayout) Generate(f game.Format, r *rand.Rand) []int {
+	perm := r.Perm(f.Balls())[:f.Numbers]
+	for i := range perm {
+		perm[i] += int(f.Min)
+	}
+	var cells []int
+	for row := 0; row < f.Rows; row++ {
+		n := f.Numbers / f.Rows
+		if row < f.Numbers%f.Rows {
+			n++
+		}
+		cells = append(cells, createRow(f.Cols, perm[:n], r)...)
+		perm = perm[n:]
+	}
+	return cells
+}
+
+// ninetyBallLayout generates UK style tickets. Each row has 5 numbers, each column has at
+// least one number, and numbers of a column are sorted top to bottom.
+type ninetyBallLayout struct{}
+
+func (ninetyBallLayout) Generate(f game.Format, r *rand.Rand) []int {
+	counts := make([]int, f.Cols)
+	for c := range counts {
+		counts[c] = 1
+	}
+	for extra := f.Numbers - f.Cols; extra > 0; {
+		c := r.Intn(f.Cols)
+		if counts[c] < f.Rows {
+			counts[c]++
+			extra--
+		}
+	}
+	columns := make([][]int, f.Cols)
+	for c, count := range counts {
+		lo, hi := ninetyBallColumn(c)
+		columns[c] = pickSorted(lo, hi, count, r)
+	}
+	return placeNumbers(f, columns, r)
+}
+
+// tickets in a strip of 90-ball tickets
+const stripSize = 6
+
+// GenerateStrip returns 6 tickets which together hold each number from 1 to 90 exactly once.
+func (ninetyBallLayout) GenerateStrip(f game.Format, r *rand.Rand) [][]int {
+	counts := stripColumnCounts(f, r)
+	columns := make([][][]int, stripSize) // sorted numbers of each column of each ticket
+	for t := range columns {
+		columns[t] = make([][]int, f.Cols)
+	}
+	for c := 0; c < f.Cols; c++ {
+		lo, hi := ninetyBallColumn(c)
+		nums := r.Perm(hi - lo + 1)
+		for t := range columns {
+			picked := append([]int(nil), nums[:counts[t][c]]...)
+			nums = nums[counts[t][c]:]
+			for i := range picked {
+				picked[i] += lo
+			}
+			sort.Ints(picked)
+			columns[t][c] = picked
+		}
+	}
+	strip := make([][]int, stripSize)
+	for t := range strip {
+		strip[t] = placeNumbers(f, columns[t], r)
+	}
+	return strip
+}
+
+// stripColumnCounts returns how many numbers each ticket of a strip has in each column. Each ticket
+// has 1 to 3 numbers in every column and 15 numbers in all, and a column of the strip holds all its numbers.
+func stripColumnCounts(f game.Format, r *rand.Rand) [][]int {
+	for {
+		if counts, ok := tryStripColumnCounts(f, r); ok {
+			return counts
+		}
+	}
+}
+
+// tryStripColumnCounts hands out numbers of each column beyond the first of every ticket to tickets which
+// need most numbers. It reports false in the unlikely case it runs into a dead end.
+func tryStripColumnCounts(f game.Format, r *rand.Rand) ([][]int, bool) {
+	counts := make([][]int, stripSize)
+	need := make([]int, stripSize)
+	for t := range counts {
+		counts[t] = make([]int, f.Cols)
+		for c := range counts[t] {
+			counts[t][c] = 1
+		}
+		need[t] = f.Numbers - f.Cols
+	}
+	for _, c := range r.Perm(f.Cols) {
+		lo, hi := ninetyBallColumn(c)
+		for extra := hi - lo + 1 - stripSize; extra > 0; extra-- {
+			best := -1
+			for _, t := range r.Perm(stripSize) {
+				if counts[t][c] < f.Rows && need[t] > 0 && (best == -1 || need[t] > need[best]) {
+					best = t
+				}
+			}
+			if best == -1 {
+				return nil, false
+			}
+			counts[best][c]++
+			need[best]--
+		}
+	}
+	return counts, true
+}
+
+// placeNumbers lays out sorted numbers of each column on a ticket, top to bottom, with the same count of numbers in each row.
+func placeNumbers(f game.Format, columns [][]int, r *rand.Rand) []int {
+	counts := make([]int, len(columns))
+	for c := range columns {
+		counts[c] = len(columns[c])
+	}
+	rows := placeColumns(counts, f.Rows, f.Numbers/f.Rows, r)
+	cells := emptyCells(f.Rows * f.Cols)
+	for c := range columns {
+		for i, n := range columns[c] {
+			cells[rows[c][i]*f.Cols+c] = n
+		}
+	}
+	return cells
+}
+
+// ninetyBallColumn returns the range of numbers of column c, first column holds 1-9 and last column holds 80-90.
+func ninetyBallColumn(c int) (lo, hi int) {
+	lo, hi = c*10, c*10+9
+	if c == 0 {
+		lo = 1
+	}
+	if c == 8 {
+		hi = 90
+	}
+	return lo, hi
+}
+
+// placeColumns picks rows for each column with counts[c] numbers, so that each row has perRow numbers.
+// Columns with most numbers are placed first, each in rows with most room left, which always finds a
+// placement when one exists. Returned rows of each column are sorted.
+func placeColumns(counts []int, rows, perRow int, r *rand.Rand) [][]int {
+	order := r.Perm(len(counts))
+	sort.SliceStable(order, func(i, j int) bool { return counts[order[i]] > counts[order[j]] })
+	room := make([]int, rows)
+	for row := range room {
+		room[row] = perRow
+	}
+	placed := make([][]int, len(counts))
+	for _, c := range order {
+		byRoom := r.Perm(rows)
+		sort.SliceStable(byRoom, func(i, j int) bool { return room[byRoom[i]] > room[byRoom[j]] })
+		chosen := append([]int(nil), byRoom[:counts[c]]...)
+		for _, row := range chosen {
+			room[row]--
+		}
+		sort.Ints(chosen)
+		placed[c] = chosen
+	}
+	return placed
+}
+
+// seventyFiveBallLayout generates US style 5x5 cards, column c holds 5 numbers from 15c+1 to 15c+15
+// and the centre is a free space.
+type seventyFiveBallLayout struct{}
+
+func (seventyFiveBallLayout) Generate(f game.Format, r *rand.Rand) []int {
+	cells := emptyCells(f.Rows * f.Cols)
+	for c := 0; c < f.Cols; c++ {
+		perm := r.Perm(15)
+		for row := 0; row < f.Rows; row++ {
+			cells[row*f.Cols+c] = c*15 + perm[row] + 1
+		}
+	}
+	cells[(f.Rows/2)*f.Cols+f.Cols/2] = int(ticket.FreeCell)
+	return cells
+}
+
+// pickSorted returns count distinct numbers from [lo, hi] in ascending order.
+func pickSorted(lo, hi, count int, r *rand.Rand) []int {
+	nums := r.Perm(hi - lo + 1)[:count]
+	for i := range nums {
+		nums[i] += lo
+	}
+	sort.Ints(nums)
+	return nums
+}
+
+func emptyCells(size int) []int {
+	cells := make([]int, size)
+	for i := range cells {
+		cells[i] = int(ticket.EmptyCell)
+	}
+	return cells
+}
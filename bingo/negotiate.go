@@ -0,0 +1,59 @@
+package bingo
+
+import (
+	"strconv"
+	"strings"
+)
+
+// media types tickets are rendered as
+const (
+	mediaHTML = "text/html"
+	mediaJSON = "application/json"
+	mediaText = "text/plain"
+)
+
+// negotiate returns the media type of offers preferred by an Accept header, the first offer wins ties.
+// The first offer is returned when accept is empty or accepts none of the offers.
+func negotiate(accept string, offers ...string) string {
+	best, bestQ := offers[0], 0.0
+	if strings.TrimSpace(accept) == "" {
+		return best
+	}
+	for _, offer := range offers {
+		if q := acceptQuality(accept, offer); q > bestQ {
+			best, bestQ = offer, q
+		}
+	}
+	return best
+}
+
+// acceptQuality returns the quality value an Accept header gives to media type offer, from its most specific matching range.
+func acceptQuality(accept, offer string) float64 {
+	q, specificity := 0.0, -1
+	for _, part := range strings.Split(accept, ",") {
+		params := strings.Split(part, ";")
+		mediaRange := strings.ToLower(strings.TrimSpace(params[0]))
+		s := -1
+		switch {
+		case mediaRange == offer:
+			s = 2
+		case mediaRange == offer[:strings.Index(offer, "/")]+"/*":
+			s = 1
+		case mediaRange == "*/*":
+			s = 0
+		}
+		if s <= specificity {
+			continue
+		}
+		specificity, q = s, 1
+		for _, p := range params[1:] {
+			p = strings.TrimSpace(p)
+			if strings.HasPrefix(p, "q=") {
+				if v, err := strconv.ParseFloat(p[2:], 64); err == nil {
+					q = v
+				}
+			}
+		}
+	}
+	return q
+}
@@ -0,0 +1,211 @@
+package bingo
+
+import (
+	crand "crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"math/rand"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/divyanshgaba/bingo/game"
+	"github.com/divyanshgaba/bingo/ticket"
+)
+
+// Source provides randomness for tickets and draws of games.
+type Source interface {
+	// Seed returns a secret seed for a new game, or empty if draws and tickets of games are not derived from a seed.
+	Seed() string
+	// Rand returns randomness for game g, for the purpose named by key. Keys are "draw:<seq>"
+	// for the seq-th number drawn, and "ticket:<nonce>" or "strip:<nonce>" for tickets.
+	Rand(g game.Game, key string) *rand.Rand
+}
+
+type cryptoSource struct{}
+
+// NewCryptoSource returns a Source backed by crypto/rand, for production use.
+func NewCryptoSource() Source {
+	return cryptoSource{}
+}
+
+func (cryptoSource) Seed() string { return "" }
+
+func (cryptoSource) Rand(game.Game, string) *rand.Rand {
+	return rand.New(cryptoRand{})
+}
+
+// cryptoRand is a rand.Source64 reading from crypto/rand, safe for concurrent use.
+type cryptoRand struct{}
+
+func (cryptoRand) Seed(int64) {}
+
+func (r cryptoRand) Int63() int64 {
+	return int64(r.Uint64() &^ (1 << 63))
+}
+
+func (cryptoRand) Uint64() uint64 {
+	var b [8]byte
+	if _, err := crand.Read(b[:]); err != nil {
+		panic(err)
+	}
+	return binary.BigEndian.Uint64(b[:])
+}
+
+type seededSource struct {
+	r *rand.Rand
+}
+
+// NewSeededSource returns a Source which produces the same tickets and draws for the same seed,
+// as long as they are requested in the same order. It is meant for reproducible tests.
+func NewSeededSource(seed int64) Source {
+	return seededSource{r: rand.New(&lockedRand{src: rand.NewSource(seed)})}
+}
+
+func (seededSource) Seed() string { return "" }
+
+func (s seededSource) Rand(game.Game, string) *rand.Rand {
+	return s.r
+}
+
+// lockedRand makes a rand.Source safe for concurrent use.
+type lockedRand struct {
+	mtx sync.Mutex
+	src rand.Source
+}
+
+func (r *lockedRand) Seed(seed int64) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	r.src.Seed(seed)
+}
+
+func (r *lockedRand) Int63() int64 {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	return r.src.Int63()
+}
+
+type commitRevealSource struct{}
+
+// NewCommitRevealSource returns a Source which gives every game a secret seed. Only a hash of the
+// seed is published while the game is played, the seed is revealed when it finishes, so that
+// players can check every draw and ticket with VerifyDraws and VerifyTicket.
+func NewCommitRevealSource() Source {
+	return commitRevealSource{}
+}
+
+func (commitRevealSource) Seed() string {
+	return newNonce(32)
+}
+
+func (commitRevealSource) Rand(g game.Game, key string) *rand.Rand {
+	return seededRand(g.Seed, key)
+}
+
+// seededRand returns math/rand seeded with the first 8 bytes of sha256(seed + ":" + key).
+func seededRand(seed, key string) *rand.Rand {
+	sum := sha256.Sum256([]byte(seed + ":" + key))
+	return rand.New(rand.NewSource(int64(binary.BigEndian.Uint64(sum[:8]))))
+}
+
+// HashSeed returns the hex encoded sha256 of seed, which is published as commitment to the seed of a game.
+func HashSeed(seed string) string {
+	sum := sha256.Sum256([]byte(seed))
+	return hex.EncodeToString(sum[:])
+}
+
+// newNonce returns n random bytes, hex encoded.
+func newNonce(n int) string {
+	b := make([]byte, n)
+	if _, err := crand.Read(b); err != nil {
+		panic(err)
+	}
+	return hex.EncodeToString(b)
+}
+
+func drawKey(seq int) string {
+	return "draw:" + strconv.Itoa(seq)
+}
+
+// drawNumber picks the next number of a game from numbers not drawn yet, in ascending order.
+func drawNumber(r *rand.Rand, format game.Format, drawn []int64) int64 {
+	set := make(map[int64]bool, len(drawn))
+	for _, n := range drawn {
+		set[n] = true
+	}
+	remaining := make([]int64, 0, format.Balls()-len(drawn))
+	for n := format.Min; n <= format.Max; n++ {
+		if !set[n] {
+			remaining = append(remaining, n)
+		}
+	}
+	return remaining[r.Intn(len(remaining))]
+}
+
+// VerifyDraws reports whether numbers drawn in a game with format were derived from seed, whose hash was published as seedHash.
+func VerifyDraws(seed, seedHash string, format game.Format, numbers []int64) bool {
+	if HashSeed(seed) != seedHash {
+		return false
+	}
+	format = format.OrClassic()
+	for i, n := range numbers {
+		if drawNumber(seededRand(seed, drawKey(i+1)), format, numbers[:i]) != n {
+			return false
+		}
+	}
+	return true
+}
+
+// VerifyTicket reports whether cells of ticket t of a game with format were derived from seed, whose hash was published as seedHash.
+func VerifyTicket(seed, seedHash string, format game.Format, t ticket.Ticket) bool {
+	if HashSeed(seed) != seedHash || t.Nonce == "" {
+		return false
+	}
+	format = format.OrClassic()
+	nonce, index, isStrip := splitStripNonce(t.Nonce)
+	var cells []int
+	if isStrip {
+		layout, ok := layouts[format.Name].(StripLayout)
+		if !ok {
+			return false
+		}
+		strip := layout.GenerateStrip(format, seededRand(seed, "strip:"+nonce))
+		if index >= len(strip) {
+			return false
+		}
+		cells = strip[index]
+	} else {
+		cells = layouts[format.Name].Generate(format, seededRand(seed, "ticket:"+nonce))
+	}
+	return arrayToString(cells, ";") == t.Cells
+}
+
+// Verify reports whether cells of ticket t were derived from seed, whose hash was published as seedHash.
+// Tickets can only be verified once the seed is revealed, when the format and nonce of t are served.
+func (t Ticket) Verify(seed, seedHash string) bool {
+	if t.Format == nil {
+		return false
+	}
+	cells := strings.Trim(strings.Replace(fmt.Sprint(t.Cells), " ", ";", -1), "[]")
+	return VerifyTicket(seed, seedHash, t.Format.format(), ticket.Ticket{Cells: cells, Nonce: t.Nonce})
+}
+
+// stripNonce returns nonce of the i-th ticket of a strip.
+func stripNonce(nonce string, i int) string {
+	return nonce + "/" + strconv.Itoa(i)
+}
+
+func splitStripNonce(s string) (nonce string, index int, isStrip bool) {
+	i := strings.LastIndex(s, "/")
+	if i == -1 {
+		return s, 0, false
+	}
+	index, err := strconv.Atoi(s[i+1:])
+	if err != nil {
+		return s, 0, false
+	}
+	return s[:i], index, true
+}
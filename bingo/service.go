@@ -2,79 +2,332 @@ package bingo
 
 import (
 	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"math/rand"
+	"net/http"
 	"strconv"
 	"strings"
-	"sync"
+	"time"
 
+	"github.com/divyanshgaba/bingo/errs"
 	"github.com/divyanshgaba/bingo/game"
+	"github.com/divyanshgaba/bingo/player"
 	"github.com/divyanshgaba/bingo/ticket"
 )
 
 // ErrInvalidArgument is returned when one or more arguments are invalid.
 var (
-	ErrInvalidArgument     = errors.New("bingo: invalid argument")
-	ErrMaxNumbersGenerated = errors.New("bingo: max number of numbers generated for the game")
+	ErrInvalidArgument     = errs.New("bingo.invalid_argument", http.StatusBadRequest, "bingo: invalid argument")
+	ErrMaxNumbersGenerated = errs.New("bingo.max_numbers_generated", http.StatusBadRequest, "bingo: max number of numbers generated for the game")
+	ErrTicketNotInGame     = errs.New("bingo.ticket_not_in_game", http.StatusBadRequest, "bingo: ticket does not belong to the game")
+	ErrInvalidClaim        = errs.New("bingo.invalid_claim", http.StatusUnprocessableEntity, "bingo: ticket does not qualify for the prize")
+	ErrTicketSalesClosed   = errs.New("bingo.ticket_sales_closed", http.StatusConflict, "bingo: tickets can only be created before the game starts")
+	ErrGameNotRunning      = errs.New("bingo.game_not_running", http.StatusConflict, "bingo: numbers can only be drawn while the game is running")
+	ErrStripsUnsupported   = errs.New("bingo.strips_unsupported", http.StatusBadRequest, "bingo: strips are not available for the game format")
+	ErrSeedNotRevealed     = errs.New("bingo.seed_not_revealed", http.StatusConflict, "bingo: seed is revealed when the game finishes")
+	ErrNumberNotOnTicket   = errs.New("bingo.number_not_on_ticket", http.StatusUnprocessableEntity, "bingo: number is not on the ticket")
+	ErrNumberNotDrawn      = errs.New("bingo.number_not_drawn", http.StatusUnprocessableEntity, "bingo: number has not been drawn in the game")
+	ErrGameSoldOut         = errs.New("bingo.game_sold_out", http.StatusConflict, "bingo: all tickets of the game have been sold")
+	ErrTicketLimitReached  = errs.New("bingo.ticket_limit_reached", http.StatusUnprocessableEntity, "bingo: user has bought the most tickets allowed for the game")
+	ErrForbidden           = errs.New("bingo.forbidden", http.StatusForbidden, "bingo: only the owner of the ticket or the host of its game can do this")
+	ErrInvalidHostSecret   = errs.New("bingo.invalid_host_secret", http.StatusForbidden, "bingo: missing or invalid host secret for the game")
 )
 
 // Service is the interface that provides bingo methods.
 type Service interface {
-	// create a new bingo game and return its ID
-	CreateGame(context.Context) (game.ID, error)
+	// create a new bingo game with tickets of the given format, sold by the given rules, and return its ID
+	// along with the secret for host operations. The authenticated player hosts the game, games created anonymously
+	// are hosted with the secret only.
+	CreateGame(context.Context, game.Format, game.Rules) (id game.ID, hostSecret string, err error)
 
-	// create a new ticket for gameID with username
+	// returns ErrInvalidHostSecret unless secret is the host secret of a game. Games created before
+	// games had host secrets can only be hosted by their host, as the authenticated player.
+	AuthorizeHost(ctx context.Context, gameID game.ID, secret string) error
+
+	// create a new ticket for gameID with username, who must be the authenticated player
 	CreateTicket(context.Context, game.ID, string) (ticket.ID, error)
 
-	// display ticket as HTML
+	// create a strip of tickets for gameID with username, which together cover every number once
+	CreateTicketStrip(context.Context, game.ID, string) (ticket.StripID, []ticket.ID, error)
+
+	// display ticket as HTML, to its owner or the host of its game
 	ShowTicket(context.Context, ticket.ID) (Ticket, error)
 
-	// generate a random number, which has not been picked earlier for this game
-	GenerateNumber(context.Context, game.ID) (int64, error)
+	// list tickets of a game in order of creation, a page of at most limit tickets after the first offset,
+	// along with the total count of tickets
+	ListTickets(ctx context.Context, gameID game.ID, offset, limit int) ([]Ticket, int, error)
+
+	// daub a number on a ticket, the number must be on the ticket and drawn in its game.
+	// Only the owner of the ticket or the host of its game can daub, unmark or turn on auto-daub.
+	MarkNumber(context.Context, ticket.ID, int64) error
+
+	// remove daub of a number from a ticket
+	UnmarkNumber(context.Context, ticket.ID, int64) error
+
+	// turn daubing of numbers drawn on a ticket automatically on or off
+	SetAutoDaub(context.Context, ticket.ID, bool) error
+
+	// generate a random number, which has not been picked earlier for this game. It is announced with call names
+	// of locale, or of the game when locale is empty. Fails with ErrGameNotRunning unless the game is running.
+	GenerateNumber(ctx context.Context, gameID game.ID, locale string) (Draw, error)
+
+	// returns numbers drawn in a game after the first `since`, in the order they were drawn, announced in locale
+	GetDraws(ctx context.Context, gameID game.ID, since int, locale string) ([]Draw, error)
+
+	// set how numbers drawn in a game are announced, with a catalogue of call names or custom names by number
+	SetCalls(context.Context, game.ID, game.Calls) error
+
+	// returns stats for a game: progress of the draw, players, tickets closest to winning and prizes claimed
+	GetStats(context.Context, game.ID) (Stats, error)
+
+	// claim a prize for a ticket of the game, if the ticket qualifies with numbers drawn so far.
+	// Only the owner of the ticket or the host of the game can claim.
+	ClaimPrize(context.Context, game.ID, ticket.ID, game.Prize) error
+
+	// watch events of a game, numbers drawn after lastEventID are replayed first.
+	// The channel is closed when ctx is done or the watcher falls behind.
+	Watch(ctx context.Context, gameID game.ID, lastEventID int64) (<-chan Event, error)
+
+	// start drawing numbers for a game in lobby
+	StartGame(context.Context, game.ID) error
+
+	// put drawing numbers on hold for a running game
+	PauseGame(context.Context, game.ID) error
+
+	// continue drawing numbers for a paused game
+	ResumeGame(context.Context, game.ID) error
+
+	// finish a game, no more numbers can be drawn after this
+	EndGame(context.Context, game.ID) error
+
+	// returns hash of the seed of a game, and the seed itself once the game finishes. Until then the hash is
+	// returned with ErrSeedNotRevealed. Games played without a seed return neither.
+	GetSeed(context.Context, game.ID) (seedHash, seed string, err error)
 
-	// returns all numbers generated so far for a game
-	GetAllNumbers(context.Context, game.ID) ([]int64, error)
+	// draw numbers of a game automatically every interval, from 100ms to 10m, until the game finishes or
+	// auto-calling is stopped
+	StartAutoCall(ctx context.Context, gameID game.ID, interval time.Duration) error
 
-	// returns stats for a game.
-	GetStats(context.Context, game.ID) (numbersDrawn, ticketsGenerated int, err error)
+	// stop drawing numbers of a game automatically
+	StopAutoCall(context.Context, game.ID) error
 }
 
 type service struct {
 	games   game.Repository
 	tickets ticket.Repository
-	mutex   *sync.Mutex
+	tx      game.Transactor
+	events  *hub
+	callers *autoCallers
+	stats   *statsCache
+	rng     Source
+	metrics Metrics
 }
 
-// NewService returns implementation of `Service`.
-func NewService(games game.Repository, tickets ticket.Repository) Service {
+// Option configures the service returned by NewService.
+type Option func(*service)
+
+// WithStatsCache caches stats of games with at least minTickets tickets, until the game changes.
+func WithStatsCache(minTickets int) Option {
+	return func(s *service) {
+		s.stats = newStatsCache(minTickets)
+	}
+}
+
+// NewService returns implementation of `Service`. Changes to games and tickets which go together are made with tx.
+func NewService(games game.Repository, tickets ticket.Repository, tx game.Transactor, rng Source, opts ...Option) Service {
 	s := &service{
 		games:   games,
 		tickets: tickets,
-		mutex:   &sync.Mutex{},
+		tx:      tx,
+		events:  newHub(),
+		callers: newAutoCallers(),
+		rng:     rng,
+		metrics: discardMetrics(),
+	}
+	for _, opt := range opts {
+		opt(s)
 	}
 	return s
 }
 
-func (s service) CreateGame(ctx context.Context) (game.ID, error) {
-	return s.games.Insert(ctx, game.Game{Tickets: []ticket.ID{}, Numbers: []int64{}})
+func (s service) CreateGame(ctx context.Context, format game.Format, rules game.Rules) (game.ID, string, error) {
+	format, err := resolveFormat(format)
+	if err != nil {
+		return "", "", err
+	}
+	if !validRules(rules) {
+		return "", "", ErrInvalidArgument
+	}
+	// games created anonymously have no host, they are hosted with the host secret only
+	host, _ := player.FromContext(ctx)
+	secret := newNonce(32)
+	g := game.Game{
+		Status:         game.Lobby,
+		Format:         format,
+		Rules:          rules,
+		Host:           host,
+		HostSecretHash: hashHostSecret(secret),
+		Tickets:        []ticket.ID{},
+		Numbers:        []int64{},
+	}
+	if seed := s.rng.Seed(); seed != "" {
+		g.Seed, g.SeedHash = seed, HashSeed(seed)
+	}
+	id, err := s.games.Insert(ctx, g)
+	if err != nil {
+		return "", "", err
+	}
+	s.metrics.GamesCreated.Add(1)
+	return id, secret, nil
+}
+
+func (s service) AuthorizeHost(ctx context.Context, gameID game.ID, secret string) error {
+	g, err := s.games.Find(ctx, gameID)
+	if err != nil {
+		return err
+	}
+	if g.HostSecretHash == "" { // created before games had host secrets
+		if p, ok := player.FromContext(ctx); ok && g.Host != "" && p == g.Host {
+			return nil
+		}
+		return ErrInvalidHostSecret
+	}
+	if subtle.ConstantTimeCompare([]byte(hashHostSecret(secret)), []byte(g.HostSecretHash)) != 1 {
+		return ErrInvalidHostSecret
+	}
+	return nil
+}
+
+// hashHostSecret returns the hash host secrets are stored by.
+func hashHostSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
 }
 
 func (s service) CreateTicket(ctx context.Context, gameID game.ID, username string) (ticket.ID, error) {
-	_, err := s.games.Find(ctx, gameID)
+	if p, ok := player.FromContext(ctx); !ok || p != username {
+		return "", ErrForbidden
+	}
+	g, err := s.games.Find(ctx, gameID)
 	if err != nil {
 		return "", err
 	}
-	ticket := ticket.Ticket{
+	if !g.SalesOpen() {
+		return "", ErrTicketSalesClosed
+	}
+	format := g.Format.OrClassic()
+	nonce := newNonce(16)
+	t := ticket.Ticket{
+		GameID:   string(gameID),
 		Username: username,
-		Cells:    generateRandomCells(),
+		Rows:     format.Rows,
+		Cols:     format.Cols,
+		Cells:    arrayToString(layouts[format.Name].Generate(format, s.rng.Rand(g, "ticket:"+nonce)), ";"),
 	}
-	ticketID, err := s.tickets.Insert(ctx, ticket)
+	if g.Seed != "" {
+		t.Nonce = nonce
+	}
+	var ticketID ticket.ID
+	err = s.tx.WithinTransaction(ctx, func(ctx context.Context, games game.Repository, tickets ticket.Repository) error {
+		if err := checkSales(ctx, games, tickets, gameID, username, 1); err != nil {
+			return err
+		}
+		var err error
+		ticketID, err = tickets.Insert(ctx, t)
+		if err != nil {
+			return err
+		}
+		return games.AddTicket(ctx, gameID, ticketID)
+	})
 	if err != nil {
 		return "", err
 	}
-	err = s.games.AddTicket(ctx, gameID, ticketID)
-	return ticketID, err
+	s.metrics.TicketsIssued.Add(1)
+	s.events.publish(Event{Type: EventTicketCreated, GameID: gameID, TicketID: ticketID})
+	return ticketID, nil
+}
+
+func (s service) CreateTicketStrip(ctx context.Context, gameID game.ID, username string) (ticket.StripID, []ticket.ID, error) {
+	if p, ok := player.FromContext(ctx); !ok || p != username {
+		return "", nil, ErrForbidden
+	}
+	g, err := s.games.Find(ctx, gameID)
+	if err != nil {
+		return "", nil, err
+	}
+	if !g.SalesOpen() {
+		return "", nil, ErrTicketSalesClosed
+	}
+	format := g.Format.OrClassic()
+	layout, ok := layouts[format.Name].(StripLayout)
+	if !ok {
+		return "", nil, ErrStripsUnsupported
+	}
+	nonce := newNonce(16)
+	var strip []ticket.Ticket
+	for i, cells := range layout.GenerateStrip(format, s.rng.Rand(g, "strip:"+nonce)) {
+		t := ticket.Ticket{
+			GameID:   string(gameID),
+			Username: username,
+			Rows:     format.Rows,
+			Cols:     format.Cols,
+			Cells:    arrayToString(cells, ";"),
+		}
+		if g.Seed != "" {
+			t.Nonce = stripNonce(nonce, i)
+		}
+		strip = append(strip, t)
+	}
+	var stripID ticket.StripID
+	var ticketIDs []ticket.ID
+	err = s.tx.WithinTransaction(ctx, func(ctx context.Context, games game.Repository, tickets ticket.Repository) error {
+		if err := checkSales(ctx, games, tickets, gameID, username, len(strip)); err != nil {
+			return err
+		}
+		var err error
+		stripID, ticketIDs, err = tickets.InsertStrip(ctx, strip)
+		if err != nil {
+			return err
+		}
+		return games.AddTicket(ctx, gameID, ticketIDs...)
+	})
+	if err != nil {
+		return "", nil, err
+	}
+	s.metrics.TicketsIssued.Add(float64(len(ticketIDs)))
+	for _, ticketID := range ticketIDs {
+		s.events.publish(Event{Type: EventTicketCreated, GameID: gameID, TicketID: ticketID})
+	}
+	return stripID, ticketIDs, nil
+}
+
+// checkSales returns an error unless username can buy n more tickets of a game, by the rules of the game.
+// It reads the game again within the unit of work which sells the tickets, so that limits hold for concurrent sales.
+func checkSales(ctx context.Context, games game.Repository, tickets ticket.Repository, gameID game.ID, username string, n int) error {
+	g, err := games.Find(ctx, gameID)
+	if err != nil {
+		return err
+	}
+	if !g.SalesOpen() {
+		return ErrTicketSalesClosed
+	}
+	if max := g.Rules.MaxTickets; max > 0 && len(g.Tickets)+n > max {
+		return ErrGameSoldOut
+	}
+	if max := g.Rules.MaxTicketsPerUser; max > 0 {
+		count, err := tickets.CountByUser(ctx, string(gameID), username)
+		if err != nil {
+			return err
+		}
+		if count+n > max {
+			return ErrTicketLimitReached
+		}
+	}
+	return nil
 }
 
 func (s service) ShowTicket(ctx context.Context, ticketID ticket.ID) (Ticket, error) {
@@ -82,55 +335,445 @@ func (s service) ShowTicket(ctx context.Context, ticketID ticket.ID) (Ticket, er
 	if err != nil {
 		return Ticket{}, err
 	}
-	return Ticket{Username: t.Username, Cells: parseCellString(t.Cells)}, nil
+	g, err := s.authorize(ctx, t)
+	if err != nil {
+		return Ticket{}, err
+	}
+	view := newTicket(t)
+	if t.GameID == "" { // created before tickets referenced their game
+		return view, nil
+	}
+	view.Drawn = onTicket(view, g.Numbers)
+	if t.AutoDaub {
+		view.Marked = onTicket(view, union(view.Marked, view.Drawn))
+	}
+	if g.SeedHash != "" && g.Status == game.Finished {
+		view.Format, view.Nonce = newFormat(g.Format), t.Nonce
+	}
+	return view, nil
+}
+
+// authorize returns ErrForbidden unless the authenticated player owns ticket t or hosts its game.
+// It returns the game of the ticket, which is zero for tickets created before tickets referenced their game.
+func (s service) authorize(ctx context.Context, t ticket.Ticket) (game.Game, error) {
+	username, ok := player.FromContext(ctx)
+	if !ok {
+		return game.Game{}, ErrForbidden
+	}
+	if t.GameID == "" {
+		if !mayAccess(username, t, game.Game{}) {
+			return game.Game{}, ErrForbidden
+		}
+		return game.Game{}, nil
+	}
+	g, err := s.games.Find(ctx, game.ID(t.GameID))
+	if err != nil {
+		return game.Game{}, err
+	}
+	if !mayAccess(username, t, g) {
+		return game.Game{}, ErrForbidden
+	}
+	return g, nil
+}
+
+// mayAccess reports whether player username owns ticket t or hosts its game g.
+func mayAccess(username string, t ticket.Ticket, g game.Game) bool {
+	return username == t.Username || (g.Host != "" && username == g.Host)
+}
+
+// page sizes for listing tickets
+const (
+	defaultPageSize = 50
+	maxPageSize     = 100
+)
+
+func (s service) ListTickets(ctx context.Context, gameID game.ID, offset, limit int) ([]Ticket, int, error) {
+	if offset < 0 || limit < 0 || limit > maxPageSize {
+		return nil, 0, ErrInvalidArgument
+	}
+	if limit == 0 {
+		limit = defaultPageSize
+	}
+	if _, err := s.games.Find(ctx, gameID); err != nil {
+		return nil, 0, err
+	}
+	ts, total, err := s.tickets.FindByGame(ctx, string(gameID), offset, limit)
+	if err != nil {
+		return nil, 0, err
+	}
+	views := make([]Ticket, len(ts))
+	for i, t := range ts {
+		views[i] = newTicket(t)
+	}
+	return views, total, nil
+}
+
+func (s service) MarkNumber(ctx context.Context, ticketID ticket.ID, number int64) error {
+	if err := s.checkDaub(ctx, ticketID, number); err != nil {
+		return err
+	}
+	return s.tickets.Mark(ctx, ticketID, number)
+}
+
+func (s service) UnmarkNumber(ctx context.Context, ticketID ticket.ID, number int64) error {
+	if err := s.checkDaub(ctx, ticketID, number); err != nil {
+		return err
+	}
+	return s.tickets.Unmark(ctx, ticketID, number)
+}
+
+func (s service) SetAutoDaub(ctx context.Context, ticketID ticket.ID, enabled bool) error {
+	t, err := s.tickets.Find(ctx, ticketID)
+	if err != nil {
+		return err
+	}
+	if _, err := s.authorize(ctx, t); err != nil {
+		return err
+	}
+	return s.tickets.SetAutoDaub(ctx, ticketID, enabled)
 }
 
-func (s service) GenerateNumber(ctx context.Context, gameID game.ID) (int64, error) {
-	s.mutex.Lock()
-	defer s.mutex.Unlock()
-	game, err := s.games.Find(ctx, gameID)
+// checkDaub returns an error unless the authenticated player may daub ticket ticketID, and number is on the ticket
+// and has been drawn in its game.
+func (s service) checkDaub(ctx context.Context, ticketID ticket.ID, number int64) error {
+	t, err := s.tickets.Find(ctx, ticketID)
+	if err != nil {
+		return err
+	}
+	g, err := s.authorize(ctx, t)
 	if err != nil {
-		return -1, err
+		return err
 	}
-	prev := game.Numbers
-	if len(prev) == 100 {
-		return -1, ErrMaxNumbersGenerated
+	if t.GameID == "" {
+		return ErrTicketNotInGame
 	}
-	set := make(map[int64]bool)
-	for _, n := range prev {
-		set[n] = true
+	if !containsNumber(newTicket(t).Cells, number) {
+		return ErrNumberNotOnTicket
 	}
-	perm := rand.Perm(100)
-	var number int64
-	for _, n := range perm {
-		if !set[int64(n)] { // not drawn before
-			number = int64(n)
-			break
+	if !containsNumber(g.Numbers, number) {
+		return ErrNumberNotDrawn
+	}
+	return nil
+}
+
+// attempts to draw a number when other draws of the same game get in the way, backing off a little longer after each
+const (
+	maxDrawAttempts = 10
+	drawBackoff     = time.Millisecond
+)
+
+func (s service) GenerateNumber(ctx context.Context, gameID game.ID, locale string) (Draw, error) {
+	if !validLocale(locale) {
+		return Draw{}, ErrInvalidArgument
+	}
+	return s.draw(ctx, gameID, false, locale)
+}
+
+// draw draws the next number of a game, by the auto-caller when auto is true and by the host otherwise.
+// Draws are retried when other draws of the game get in the way.
+func (s service) draw(ctx context.Context, gameID game.ID, auto bool, locale string) (Draw, error) {
+	for attempt := 1; ; attempt++ {
+		d, err := s.generateNumber(ctx, gameID, auto, locale)
+		if !errors.Is(err, game.ErrConcurrentUpdate) || attempt == maxDrawAttempts {
+			return d, err
 		}
+		select {
+		case <-time.After(time.Duration(rand.Int63n(int64(attempt) * int64(drawBackoff)))):
+		case <-ctx.Done():
+			return Draw{}, ctx.Err()
+		}
+	}
+}
+
+// generateNumber draws the next number of a game, returns game.ErrConcurrentUpdate if the game changed while drawing.
+func (s service) generateNumber(ctx context.Context, gameID game.ID, auto bool, locale string) (Draw, error) {
+	g, err := s.games.Find(ctx, gameID)
+	if err != nil {
+		return Draw{}, err
+	}
+	prev := g.Numbers
+	format := g.Format.OrClassic()
+	if len(prev) >= format.Balls() {
+		return Draw{}, ErrMaxNumbersGenerated
+	}
+	if g.Status != game.Running {
+		return Draw{}, ErrGameNotRunning
+	}
+	seq := len(prev) + 1
+	number := drawNumber(s.rng.Rand(g, drawKey(seq)), format, prev)
+	d := game.Draw{Seq: seq, Number: number, DrawnAt: time.Now().UTC(), Caller: g.Host}
+	switch {
+	case auto:
+		d.Caller = game.CallerAuto
+	case g.Host == "":
+		d.Caller = game.CallerHost
+	}
+	err = s.games.AddNumber(ctx, gameID, d)
+	if err != nil {
+		return Draw{}, err
 	}
-	err = s.games.AddNumber(ctx, gameID, number)
-	return number, err
+	s.metrics.NumbersDrawn.Add(1)
+	s.events.publish(numberDrawnEvent(gameID, seq, number))
+	if seq == format.Balls() {
+		return newDraw(g, d, locale), s.EndGame(ctx, gameID)
+	}
+	return newDraw(g, d, locale), nil
 }
-func (s service) GetAllNumbers(ctx context.Context, gameID game.ID) ([]int64, error) {
+
+func (s service) GetDraws(ctx context.Context, gameID game.ID, since int, locale string) ([]Draw, error) {
+	if since < 0 || !validLocale(locale) {
+		return nil, ErrInvalidArgument
+	}
 	g, err := s.games.Find(ctx, gameID)
 	if err != nil {
-		return []int64{}, err
+		return nil, err
+	}
+	if since > len(g.Draws) {
+		since = len(g.Draws)
 	}
-	return g.Numbers, nil
+	draws := make([]Draw, 0, len(g.Draws)-since)
+	for _, d := range g.Draws[since:] {
+		draws = append(draws, newDraw(g, d, locale))
+	}
+	return draws, nil
 }
 
-func (s service) GetStats(ctx context.Context, gameID game.ID) (numbersDrawn, ticketsGenerated int, err error) {
+func (s service) SetCalls(ctx context.Context, gameID game.ID, calls game.Calls) error {
 	g, err := s.games.Find(ctx, gameID)
 	if err != nil {
-		return 0, 0, err
+		return err
+	}
+	if !validCalls(calls, g.Format.OrClassic()) {
+		return ErrInvalidArgument
 	}
-	return len(g.Numbers), len(g.Tickets), nil
+	return s.games.SetCalls(ctx, gameID, calls)
+}
+
+func (s service) ClaimPrize(ctx context.Context, gameID game.ID, ticketID ticket.ID, prize game.Prize) error {
+	if !validPrize(prize) {
+		return ErrInvalidArgument
+	}
+	g, err := s.games.Find(ctx, gameID)
+	if err != nil {
+		return err
+	}
+	if !containsTicket(g.Tickets, ticketID) {
+		return ErrTicketNotInGame
+	}
+	if _, claimed := g.Winner(prize); claimed {
+		return game.ErrPrizeClaimed
+	}
+	t, err := s.tickets.Find(ctx, ticketID)
+	if err != nil {
+		return err
+	}
+	if username, ok := player.FromContext(ctx); !ok || !mayAccess(username, t, g) {
+		return ErrForbidden
+	}
+	if !qualifies(prize, newTicket(t), g.Numbers) {
+		return ErrInvalidClaim
+	}
+	err = s.games.AddWinner(ctx, gameID, game.Winner{Prize: prize, Ticket: ticketID})
+	if err != nil {
+		return err
+	}
+	if prize == game.FullHouse && g.Status != game.Finished {
+		return s.EndGame(ctx, gameID)
+	}
+	return nil
+}
+
+func (s service) StartGame(ctx context.Context, gameID game.ID) error {
+	return s.transition(ctx, gameID, game.Lobby, game.Running)
+}
+
+func (s service) PauseGame(ctx context.Context, gameID game.ID) error {
+	return s.transition(ctx, gameID, game.Running, game.Paused)
+}
+
+func (s service) ResumeGame(ctx context.Context, gameID game.ID) error {
+	return s.transition(ctx, gameID, game.Paused, game.Running)
+}
+
+func (s service) EndGame(ctx context.Context, gameID game.ID) error {
+	g, err := s.games.Find(ctx, gameID)
+	if err != nil {
+		return err
+	}
+	if err := s.transition(ctx, gameID, g.Status, game.Finished); err != nil {
+		return err
+	}
+	s.events.publish(Event{Type: EventGameFinished, GameID: gameID})
+	return nil
+}
+
+func (s service) GetSeed(ctx context.Context, gameID game.ID) (seedHash, seed string, err error) {
+	g, err := s.games.Find(ctx, gameID)
+	if err != nil {
+		return "", "", err
+	}
+	if g.SeedHash != "" && g.Status != game.Finished {
+		return g.SeedHash, "", ErrSeedNotRevealed
+	}
+	return g.SeedHash, g.Seed, nil
+}
+
+// transition moves game from status `from` to `to`, if the game is in status `from`.
+func (s service) transition(ctx context.Context, gameID game.ID, from, to game.Status) error {
+	if !from.CanTransition(to) {
+		return game.ErrInvalidTransition
+	}
+	return s.games.SetStatus(ctx, gameID, from, to)
+}
+
+func (s service) Watch(ctx context.Context, gameID game.ID, lastEventID int64) (<-chan Event, error) {
+	// subscribe before reading the game, so no draw is missed between replay and live events
+	sub := s.events.subscribe(gameID)
+	g, err := s.games.Find(ctx, gameID)
+	if err != nil {
+		s.events.unsubscribe(gameID, sub)
+		return nil, err
+	}
+	if lastEventID < 0 {
+		lastEventID = 0
+	}
+	out := make(chan Event)
+	go func() {
+		defer close(out)
+		defer s.events.unsubscribe(gameID, sub)
+		send := func(e Event) bool {
+			select {
+			case out <- e:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+		last := lastEventID
+		for ; last < int64(len(g.Numbers)); last++ {
+			if !send(numberDrawnEvent(gameID, int(last+1), g.Numbers[last])) {
+				return
+			}
+		}
+		if g.Status == game.Finished && !send(Event{Type: EventGameFinished, GameID: gameID}) {
+			return
+		}
+		for {
+			select {
+			case e, ok := <-sub:
+				if !ok {
+					return
+				}
+				if e.Type == EventNumberDrawn && e.ID <= last { // already replayed
+					continue
+				}
+				if !send(e) {
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out, nil
 }
 
-// Ticket is a read model for tickets.
+// Ticket is a read model for tickets. Drawn are numbers of the ticket drawn so far in its game,
+// Marked are numbers daubed by the player, including drawn numbers when AutoDaub is on. Both are in order of cells.
+// Format and Nonce are set once the seed of the game is revealed, so players can verify the ticket.
 type Ticket struct {
-	Username string  `json:"username,omitempty"`
-	Cells    []int64 `json:"cells,omitempty"`
+	ID        ticket.ID      `json:"id,omitempty"`
+	GameID    game.ID        `json:"game_id,omitempty"`
+	StripID   ticket.StripID `json:"strip_id,omitempty"`
+	Username  string         `json:"username,omitempty"`
+	Rows      int            `json:"rows,omitempty"`
+	Cols      int            `json:"cols,omitempty"`
+	Cells     []int64        `json:"cells,omitempty"`
+	Marked    []int64        `json:"marked,omitempty"`
+	Drawn     []int64        `json:"drawn,omitempty"`
+	AutoDaub  bool           `json:"auto_daub,omitempty"`
+	CreatedAt time.Time      `json:"created_at"`
+	Format    *Format        `json:"format,omitempty"`
+	Nonce     string         `json:"nonce,omitempty"`
+}
+
+// Format is a read model for the format of a game.
+type Format struct {
+	Name    string `json:"name"`
+	Rows    int    `json:"rows"`
+	Cols    int    `json:"cols"`
+	Min     int64  `json:"min"`
+	Max     int64  `json:"max"`
+	Numbers int    `json:"numbers"`
+}
+
+func newFormat(f game.Format) *Format {
+	f = f.OrClassic()
+	return &Format{Name: f.Name, Rows: f.Rows, Cols: f.Cols, Min: f.Min, Max: f.Max, Numbers: f.Numbers}
+}
+
+func (f Format) format() game.Format {
+	return game.Format{Name: f.Name, Rows: f.Rows, Cols: f.Cols, Min: f.Min, Max: f.Max, Numbers: f.Numbers}
+}
+
+// Draw is a read model for numbers drawn in a game, with the text a caller reads out for the number.
+type Draw struct {
+	Seq          int        `json:"seq"`
+	Number       int64      `json:"number"`
+	DrawnAt      *time.Time `json:"drawn_at,omitempty"`
+	Caller       string     `json:"caller,omitempty"`
+	Announcement string     `json:"announcement"`
+}
+
+// newDraw returns the read model of draw d of game g, announced in locale.
+func newDraw(g game.Game, d game.Draw, locale string) Draw {
+	v := Draw{Seq: d.Seq, Number: d.Number, Caller: d.Caller, Announcement: announce(g, locale, d.Number)}
+	if !d.DrawnAt.IsZero() {
+		v.DrawnAt = &d.DrawnAt
+	}
+	return v
+}
+
+func newTicket(t ticket.Ticket) Ticket {
+	rows, cols := t.Rows, t.Cols
+	if rows == 0 || cols == 0 { // created before formats were introduced
+		rows, cols = game.Classic.Rows, game.Classic.Cols
+	}
+	view := Ticket{
+		ID:        t.ID,
+		GameID:    game.ID(t.GameID),
+		StripID:   t.StripID,
+		Username:  t.Username,
+		Rows:      rows,
+		Cols:      cols,
+		Cells:     parseCellString(t.Cells),
+		AutoDaub:  t.AutoDaub,
+		CreatedAt: t.CreatedAt,
+	}
+	view.Marked = onTicket(view, t.Marked)
+	return view
+}
+
+// onTicket returns numbers of ticket t which are in numbers, in order of cells.
+func onTicket(t Ticket, numbers []int64) []int64 {
+	var on []int64
+	for _, c := range t.Cells {
+		if c != ticket.EmptyCell && c != ticket.FreeCell && containsNumber(numbers, c) {
+			on = append(on, c)
+		}
+	}
+	return on
+}
+
+// union returns numbers of a followed by numbers of b which are not in a.
+func union(a, b []int64) []int64 {
+	u := append([]int64(nil), a...)
+	for _, n := range b {
+		if !containsNumber(u, n) {
+			u = append(u, n)
+		}
+	}
+	return u
 }
 
 func parseCellString(cells string) []int64 {
@@ -143,25 +786,16 @@ func parseCellString(cells string) []int64 {
 	return intVals
 }
 
-func generateRandomCells() string {
-	perm := rand.Perm(100)[0:15] // ticket will have exactly 15 numbers, in range [0,100)
-	var cells []int
-	cells = append(cells, createRow(9, perm[:5])...)
-	cells = append(cells, createRow(9, perm[5:10])...)
-	cells = append(cells, createRow(9, perm[10:])...)
-	return arrayToString(cells, ";")
-}
-
 func arrayToString(a []int, delim string) string {
 	return strings.Trim(strings.Replace(fmt.Sprint(a), " ", delim, -1), "[]")
 }
 
 // create row of `size` with `values` randomly populated. Lenght of `values` must be less than or equal to size.
-func createRow(size int, values []int) []int {
+func createRow(size int, values []int, r *rand.Rand) []int {
 	if len(values) >= size { // limit
 		values = values[:size]
 	}
-	perm := rand.Perm(size)[:len(values)]
+	perm := r.Perm(size)[:len(values)]
 	row := make([]int, size)
 	for i := range row {
 		row[i] = -1
@@ -171,3 +805,103 @@ func createRow(size int, values []int) []int {
 	}
 	return row
 }
+
+func validRules(r game.Rules) bool {
+	if r.MaxTickets < 0 || r.MaxTicketsPerUser < 0 {
+		return false
+	}
+	switch r.SalesCutoff {
+	case "", game.CutoffStart, game.CutoffFirstDraw:
+		return true
+	default:
+		return false
+	}
+}
+
+func validPrize(prize game.Prize) bool {
+	for _, p := range game.Prizes {
+		if p == prize {
+			return true
+		}
+	}
+	return false
+}
+
+func containsTicket(tickets []ticket.ID, ticketID ticket.ID) bool {
+	for _, t := range tickets {
+		if t == ticketID {
+			return true
+		}
+	}
+	return false
+}
+
+func containsNumber(numbers []int64, number int64) bool {
+	for _, n := range numbers {
+		if n == number {
+			return true
+		}
+	}
+	return false
+}
+
+// qualifies reports whether ticket `t` wins `prize` with `numbers` drawn.
+// Free cells are always drawn and do not count towards early five.
+func qualifies(prize game.Prize, t Ticket, numbers []int64) bool {
+	if !validLayout(t) {
+		return false
+	}
+	drawn := make(map[int64]bool, len(numbers))
+	for _, n := range numbers {
+		drawn[n] = true
+	}
+	total, away := countNumbers(prizeCells(prize, t), drawn)
+	if prize == game.EarlyFive {
+		return total-away >= 5
+	}
+	return total > 0 && away == 0
+}
+
+// numbersAway returns how many numbers ticket `t` needs to win a line or full house `prize` with `drawn` numbers.
+func numbersAway(prize game.Prize, t Ticket, drawn map[int64]bool) int {
+	_, away := countNumbers(prizeCells(prize, t), drawn)
+	return away
+}
+
+// countNumbers returns the count of numbers in cells, and of those which have not been drawn.
+func countNumbers(cells []int64, drawn map[int64]bool) (total, away int) {
+	for _, c := range cells {
+		if c == ticket.EmptyCell || c == ticket.FreeCell {
+			continue
+		}
+		total++
+		if !drawn[c] {
+			away++
+		}
+	}
+	return total, away
+}
+
+// prizeCells returns cells of ticket `t` which make up `prize`. Lines are the first, middle and last rows of the ticket.
+func prizeCells(prize game.Prize, t Ticket) []int64 {
+	switch prize {
+	case game.TopLine:
+		return row(t, 0)
+	case game.MiddleLine:
+		return row(t, t.Rows/2)
+	case game.BottomLine:
+		return row(t, t.Rows-1)
+	default:
+		return t.Cells
+	}
+}
+
+// validLayout reports whether ticket `t` has a cell for each of its rows and columns.
+func validLayout(t Ticket) bool {
+	return t.Rows >= 1 && len(t.Cells) == t.Rows*t.Cols
+}
+
+// row returns cells of row r of ticket t.
+func row(t Ticket, r int) []int64 {
+	return t.Cells[r*t.Cols : (r+1)*t.Cols]
+}
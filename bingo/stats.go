@@ -0,0 +1,200 @@
+package bingo
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/divyanshgaba/bingo/game"
+	"github.com/divyanshgaba/bingo/ticket"
+)
+
+// Stats is a read model for the progress of a game.
+type Stats struct {
+	NumbersDrawn     int `json:"numbers_drawn,omitempty"`
+	NumbersRemaining int `json:"numbers_remaining"`
+	TicketsGenerated int `json:"tickets_generated,omitempty"`
+	Players          int `json:"players"`
+	// times of the first and last numbers drawn with a timestamp, and numbers drawn per minute in between
+	FirstDrawnAt   *time.Time    `json:"first_drawn_at,omitempty"`
+	LastDrawnAt    *time.Time    `json:"last_drawn_at,omitempty"`
+	DrawsPerMinute float64       `json:"draws_per_minute,omitempty"`
+	Prizes         []PrizeStatus `json:"prizes"`
+	// Leaderboard lists tickets closest to winning, at most leaderboardSize of them.
+	Leaderboard []Standing `json:"leaderboard"`
+}
+
+// PrizeStatus tells whether a prize of a game has been claimed, and by which ticket.
+type PrizeStatus struct {
+	Prize    game.Prize `json:"prize"`
+	Claimed  bool       `json:"claimed"`
+	TicketID ticket.ID  `json:"ticket_id,omitempty"`
+}
+
+// Standing tells how many numbers a ticket still needs for a full house, and for its closest line.
+type Standing struct {
+	TicketID      ticket.ID `json:"ticket_id"`
+	Username      string    `json:"username"`
+	FullHouseAway int       `json:"full_house_away"`
+	LineAway      int       `json:"line_away"`
+}
+
+// count of tickets on the leaderboard of a game
+const leaderboardSize = 10
+
+func (s service) GetStats(ctx context.Context, gameID game.ID) (Stats, error) {
+	g, err := s.games.Find(ctx, gameID)
+	if err != nil {
+		return Stats{}, err
+	}
+	if stats, ok := s.stats.get(g); ok {
+		return stats, nil
+	}
+	ts, err := s.gameTickets(ctx, gameID)
+	if err != nil {
+		return Stats{}, err
+	}
+	stats := newStats(g, ts)
+	s.stats.put(g, stats)
+	return stats, nil
+}
+
+// gameTickets returns all tickets of a game, read a page at a time.
+func (s service) gameTickets(ctx context.Context, gameID game.ID) ([]ticket.Ticket, error) {
+	var all []ticket.Ticket
+	for {
+		ts, total, err := s.tickets.FindByGame(ctx, string(gameID), len(all), maxPageSize)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, ts...)
+		if len(ts) == 0 || len(all) >= total {
+			return all, nil
+		}
+	}
+}
+
+// newStats computes stats of game g with tickets ts.
+func newStats(g game.Game, ts []ticket.Ticket) Stats {
+	stats := Stats{
+		NumbersDrawn:     len(g.Numbers),
+		NumbersRemaining: g.Format.OrClassic().Balls() - len(g.Numbers),
+		TicketsGenerated: len(g.Tickets),
+		Prizes:           make([]PrizeStatus, len(game.Prizes)),
+		Leaderboard:      []Standing{},
+	}
+	for i, p := range game.Prizes {
+		w, claimed := g.Winner(p)
+		stats.Prizes[i] = PrizeStatus{Prize: p, Claimed: claimed, TicketID: w.Ticket}
+	}
+	var timed []time.Time
+	for _, d := range g.Draws {
+		if !d.DrawnAt.IsZero() { // drawn before draws were timestamped
+			timed = append(timed, d.DrawnAt)
+		}
+	}
+	if n := len(timed); n > 0 {
+		first, last := timed[0], timed[n-1]
+		stats.FirstDrawnAt, stats.LastDrawnAt = &first, &last
+		if minutes := last.Sub(first).Minutes(); n > 1 && minutes > 0 {
+			stats.DrawsPerMinute = float64(n-1) / minutes
+		}
+	}
+
+	drawn := make(map[int64]bool, len(g.Numbers))
+	for _, n := range g.Numbers {
+		drawn[n] = true
+	}
+	players := make(map[string]bool)
+	var standings []Standing
+	for _, t := range ts {
+		players[t.Username] = true
+		view := newTicket(t)
+		if !validLayout(view) {
+			continue
+		}
+		standing := Standing{
+			TicketID:      t.ID,
+			Username:      t.Username,
+			FullHouseAway: numbersAway(game.FullHouse, view, drawn),
+			LineAway:      numbersAway(game.TopLine, view, drawn),
+		}
+		for _, p := range []game.Prize{game.MiddleLine, game.BottomLine} {
+			if away := numbersAway(p, view, drawn); away < standing.LineAway {
+				standing.LineAway = away
+			}
+		}
+		standings = append(standings, standing)
+	}
+	stats.Players = len(players)
+	sort.SliceStable(standings, func(i, j int) bool {
+		if standings[i].FullHouseAway != standings[j].FullHouseAway {
+			return standings[i].FullHouseAway < standings[j].FullHouseAway
+		}
+		return standings[i].LineAway < standings[j].LineAway
+	})
+	if len(standings) > leaderboardSize {
+		standings = standings[:leaderboardSize]
+	}
+	stats.Leaderboard = append(stats.Leaderboard, standings...)
+	return stats
+}
+
+// statsCache keeps stats of games with many tickets, for as long as the game does not change.
+// A nil cache keeps nothing.
+type statsCache struct {
+	mtx        sync.Mutex
+	minTickets int
+	entries    map[game.ID]cachedStats
+}
+
+type cachedStats struct {
+	version statsVersion
+	stats   Stats
+}
+
+// statsVersion changes whenever a game changes in a way which changes its stats.
+type statsVersion struct {
+	status                    game.Status
+	tickets, numbers, winners int
+}
+
+func versionOf(g game.Game) statsVersion {
+	return statsVersion{status: g.Status, tickets: len(g.Tickets), numbers: len(g.Numbers), winners: len(g.Winners)}
+}
+
+// most games stats are cached for, an arbitrary entry is evicted to make room for another game
+const maxCachedStats = 1000
+
+func newStatsCache(minTickets int) *statsCache {
+	return &statsCache{minTickets: minTickets, entries: make(map[game.ID]cachedStats)}
+}
+
+func (c *statsCache) get(g game.Game) (Stats, bool) {
+	if c == nil {
+		return Stats{}, false
+	}
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	e, ok := c.entries[g.ID]
+	if !ok || e.version != versionOf(g) {
+		return Stats{}, false
+	}
+	return e.stats, true
+}
+
+func (c *statsCache) put(g game.Game, stats Stats) {
+	if c == nil || len(g.Tickets) < c.minTickets {
+		return
+	}
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	if _, ok := c.entries[g.ID]; !ok && len(c.entries) >= maxCachedStats {
+		for id := range c.entries {
+			delete(c.entries, id)
+			break
+		}
+	}
+	c.entries[g.ID] = cachedStats{version: versionOf(g), stats: stats}
+}
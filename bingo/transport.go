@@ -4,56 +4,110 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
+	"html"
+	"io"
 	"net/http"
 	"strconv"
+	"strings"
+	"time"
 
+	"github.com/divyanshgaba/bingo/account"
+	"github.com/divyanshgaba/bingo/errs"
 	"github.com/divyanshgaba/bingo/game"
 	"github.com/divyanshgaba/bingo/ticket"
 	"github.com/gorilla/mux"
+	"github.com/gorilla/websocket"
 
+	"github.com/go-kit/kit/endpoint"
 	kitlog "github.com/go-kit/kit/log"
 	"github.com/go-kit/kit/transport"
 	kithttp "github.com/go-kit/kit/transport/http"
 )
 
-var errBadRoute = errors.New("bingo: bad route")
+var errBadRoute = errs.New("bingo.bad_route", http.StatusNotFound, "bingo: bad route")
 
-// MakeHandler returns a handler for the bingo service.
-func MakeHandler(bs Service, logger kitlog.Logger) http.Handler {
+// HostSecretHeader is the header the host secret of a game is passed in, for host operations.
+const HostSecretHeader = "X-Host-Secret"
+
+type contextKey int
+
+const hostSecretKey contextKey = iota
+
+// hostSecretToContext moves the host secret of a request into the context.
+func hostSecretToContext(ctx context.Context, r *http.Request) context.Context {
+	return context.WithValue(ctx, hostSecretKey, r.Header.Get(HostSecretHeader))
+}
+
+// MakeHandler returns a handler for the bingo service. Requests which act as a player are authenticated with as.
+func MakeHandler(bs Service, as account.Service, logger kitlog.Logger) http.Handler {
+	errorHandler := errs.NewLogErrorHandler(logger)
 	opts := []kithttp.ServerOption{
-		kithttp.ServerErrorHandler(transport.NewLogErrorHandler(logger)),
-		kithttp.ServerErrorEncoder(encodeError),
+		kithttp.ServerErrorHandler(errorHandler),
+		kithttp.ServerErrorEncoder(errs.EncodeError),
+		kithttp.ServerBefore(account.HTTPToContext, hostSecretToContext),
 	}
+	authenticate := account.Authenticate(as)
+	// host operations on games created before host secrets are authorized by the player hosting them
+	identify := account.Identify(as)
+	host := endpoint.Chain(identify, requireHost(bs))
 	createGameHandler := kithttp.NewServer(
-		makeCreateGameEndpoint(bs),
+		identify(makeCreateGameEndpoint(bs)),
 		decodeCreateGameRequest,
 		encodeResponse,
 		opts...,
 	)
+	authorizeHostHandler := kithttp.NewServer(
+		identify(makeAuthorizeHostEndpoint(bs)),
+		decodeAuthorizeHostRequest,
+		encodeResponse,
+		opts...,
+	)
 	createTicketHandler := kithttp.NewServer(
-		makeCreateTicketEndpoint(bs),
+		authenticate(makeCreateTicketEndpoint(bs)),
 		decodeCreateTicketRequest,
 		encodeResponse,
 		opts...,
 	)
 
+	createTicketStripHandler := kithttp.NewServer(
+		authenticate(makeCreateTicketStripEndpoint(bs)),
+		decodeCreateTicketStripRequest,
+		encodeResponse,
+		opts...,
+	)
+
 	generateNumberHandler := kithttp.NewServer(
-		makeGenerateNumberEndpoint(bs),
+		host(makeGenerateNumberEndpoint(bs)),
 		decodeGenerateNumberRequest,
 		encodeResponse,
 		opts...,
 	)
 
 	showTicketHandler := kithttp.NewServer(
-		makeShowTicketEndpoint(bs),
+		authenticate(makeShowTicketEndpoint(bs)),
 		decodeShowTicketRequest,
 		encodeShowTicketResponse,
+		append(opts, kithttp.ServerBefore(kithttp.PopulateRequestContext))...,
+	)
+
+	getTicketHandler := kithttp.NewServer(
+		authenticate(makeShowTicketEndpoint(bs)),
+		decodeShowTicketRequest,
+		encodeTicketResponse,
 		opts...,
 	)
 
-	getAllNumbersHandler := kithttp.NewServer(
-		makeGetAllNumbersEndpoint(bs),
-		decodeGetAllNumbersRequest,
+	listTicketsHandler := kithttp.NewServer(
+		makeListTicketsEndpoint(bs),
+		decodeListTicketsRequest,
+		encodeResponse,
+		opts...,
+	)
+
+	getDrawsHandler := kithttp.NewServer(
+		makeGetDrawsEndpoint(bs),
+		decodeGetDrawsRequest,
 		encodeResponse,
 		opts...,
 	)
@@ -65,20 +119,161 @@ func MakeHandler(bs Service, logger kitlog.Logger) http.Handler {
 		opts...,
 	)
 
+	claimPrizeHandler := kithttp.NewServer(
+		authenticate(makeClaimPrizeEndpoint(bs)),
+		decodeClaimPrizeRequest,
+		encodeResponse,
+		opts...,
+	)
+
+	markNumberHandler := kithttp.NewServer(
+		authenticate(makeMarkNumberEndpoint(bs)),
+		decodeMarkNumberRequest(true),
+		encodeResponse,
+		opts...,
+	)
+
+	unmarkNumberHandler := kithttp.NewServer(
+		authenticate(makeMarkNumberEndpoint(bs)),
+		decodeMarkNumberRequest(false),
+		encodeResponse,
+		opts...,
+	)
+
+	setAutoDaubHandler := kithttp.NewServer(
+		authenticate(makeSetAutoDaubEndpoint(bs)),
+		decodeSetAutoDaubRequest,
+		encodeResponse,
+		opts...,
+	)
+
+	startGameHandler := kithttp.NewServer(
+		host(makeStartGameEndpoint(bs)),
+		decodeChangeStatusRequest,
+		encodeResponse,
+		opts...,
+	)
+
+	pauseGameHandler := kithttp.NewServer(
+		host(makePauseGameEndpoint(bs)),
+		decodeChangeStatusRequest,
+		encodeResponse,
+		opts...,
+	)
+
+	resumeGameHandler := kithttp.NewServer(
+		host(makeResumeGameEndpoint(bs)),
+		decodeChangeStatusRequest,
+		encodeResponse,
+		opts...,
+	)
+
+	endGameHandler := kithttp.NewServer(
+		host(makeEndGameEndpoint(bs)),
+		decodeChangeStatusRequest,
+		encodeResponse,
+		opts...,
+	)
+
+	startAutoCallHandler := kithttp.NewServer(
+		host(makeAutoCallEndpoint(bs)),
+		decodeAutoCallRequest(true),
+		encodeResponse,
+		opts...,
+	)
+
+	stopAutoCallHandler := kithttp.NewServer(
+		host(makeAutoCallEndpoint(bs)),
+		decodeAutoCallRequest(false),
+		encodeResponse,
+		opts...,
+	)
+
+	setCallsHandler := kithttp.NewServer(
+		host(makeSetCallsEndpoint(bs)),
+		decodeSetCallsRequest,
+		encodeResponse,
+		opts...,
+	)
+
+	getSeedHandler := kithttp.NewServer(
+		makeGetSeedEndpoint(bs),
+		decodeGetSeedRequest,
+		encodeResponse,
+		opts...,
+	)
+
 	r := mux.NewRouter()
 
 	r.Handle("/api/game/create", createGameHandler).Methods(http.MethodPost)
+	r.Handle("/api/game/{gameId}/host", authorizeHostHandler).Methods(http.MethodPost)
 	r.Handle("/api/game/{gameId}/ticket/{username}/generate", createTicketHandler).Methods(http.MethodPost)
+	r.Handle("/api/game/{gameId}/strip/{username}/generate", createTicketStripHandler).Methods(http.MethodPost)
 	r.Handle("/api/game/{gameId}/number/random", generateNumberHandler).Methods(http.MethodGet)
 	r.Handle("/ticket/{ticketId}", showTicketHandler).Methods(http.MethodGet)
-	r.Handle("/api/game/{gameId}/numbers", getAllNumbersHandler).Methods(http.MethodGet)
+	r.Handle("/api/ticket/{ticketId}", getTicketHandler).Methods(http.MethodGet)
+	r.Handle("/api/game/{gameId}/tickets", listTicketsHandler).Methods(http.MethodGet)
+	r.Handle("/api/game/{gameId}/numbers", getDrawsHandler).Methods(http.MethodGet)
 	r.Handle("/api/game/{gameId}/stats", getStatsHandler).Methods(http.MethodGet)
+	r.Handle("/api/game/{gameId}/ticket/{ticketId}/claim", claimPrizeHandler).Methods(http.MethodPost)
+	r.Handle("/api/ticket/{ticketId}/mark/{number}", markNumberHandler).Methods(http.MethodPost)
+	r.Handle("/api/ticket/{ticketId}/unmark/{number}", unmarkNumberHandler).Methods(http.MethodPost)
+	r.Handle("/api/ticket/{ticketId}/autodaub", setAutoDaubHandler).Methods(http.MethodPost)
+	r.Handle("/api/game/{gameId}/start", startGameHandler).Methods(http.MethodPost)
+	r.Handle("/api/game/{gameId}/pause", pauseGameHandler).Methods(http.MethodPost)
+	r.Handle("/api/game/{gameId}/resume", resumeGameHandler).Methods(http.MethodPost)
+	r.Handle("/api/game/{gameId}/end", endGameHandler).Methods(http.MethodPost)
+	r.Handle("/api/game/{gameId}/autocall/start", startAutoCallHandler).Methods(http.MethodPost)
+	r.Handle("/api/game/{gameId}/autocall/stop", stopAutoCallHandler).Methods(http.MethodPost)
+	r.Handle("/api/game/{gameId}/calls", setCallsHandler).Methods(http.MethodPost)
+	r.Handle("/api/game/{gameId}/seed", getSeedHandler).Methods(http.MethodGet)
+	r.Handle("/api/game/{gameId}/events", makeEventStreamHandler(bs, errorHandler)).Methods(http.MethodGet)
+	r.Handle("/api/game/{gameId}/events/ws", makeEventSocketHandler(bs, errorHandler, logger)).Methods(http.MethodGet)
+	r.NotFoundHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		errs.EncodeError(r.Context(), errBadRoute, w)
+	})
 
 	return r
 }
 
 func decodeCreateGameRequest(_ context.Context, r *http.Request) (interface{}, error) {
-	return createGameRequest{}, nil
+	var body struct {
+		Format  string `json:"format"`
+		Rows    int    `json:"rows"`
+		Cols    int    `json:"cols"`
+		Min     int64  `json:"min"`
+		Max     int64  `json:"max"`
+		Numbers int    `json:"numbers"`
+
+		MaxTicketsPerUser int              `json:"max_tickets_per_user"`
+		MaxTickets        int              `json:"max_tickets"`
+		SalesCutoff       game.SalesCutoff `json:"sales_cutoff"`
+	}
+	// body is optional, games are created in classic format without one
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil && err != io.EOF {
+		return nil, ErrInvalidArgument
+	}
+	return createGameRequest{Format: game.Format{
+		Name:    body.Format,
+		Rows:    body.Rows,
+		Cols:    body.Cols,
+		Min:     body.Min,
+		Max:     body.Max,
+		Numbers: body.Numbers,
+	}, Rules: game.Rules{
+		MaxTicketsPerUser: body.MaxTicketsPerUser,
+		MaxTickets:        body.MaxTickets,
+		SalesCutoff:       body.SalesCutoff,
+	}}, nil
+}
+
+func decodeAuthorizeHostRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	vars := mux.Vars(r)
+	gameID, ok := vars["gameId"]
+	if !ok {
+		return nil, errBadRoute
+	}
+	return authorizeHostRequest{GameID: game.ID(gameID), Secret: r.Header.Get(HostSecretHeader)}, nil
 }
 
 func decodeCreateTicketRequest(_ context.Context, r *http.Request) (interface{}, error) {
@@ -94,22 +289,43 @@ func decodeCreateTicketRequest(_ context.Context, r *http.Request) (interface{},
 	return createTicketRequest{GameID: game.ID(gameID), Username: username}, nil
 }
 
+func decodeCreateTicketStripRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	vars := mux.Vars(r)
+	gameID, ok := vars["gameId"]
+	if !ok {
+		return nil, errBadRoute
+	}
+	username, ok := vars["username"]
+	if !ok {
+		return nil, errBadRoute
+	}
+	return createTicketStripRequest{GameID: game.ID(gameID), Username: username}, nil
+}
+
 func decodeGenerateNumberRequest(_ context.Context, r *http.Request) (interface{}, error) {
 	vars := mux.Vars(r)
 	gameID, ok := vars["gameId"]
 	if !ok {
 		return nil, errBadRoute
 	}
-	return generateNumberRequest{GameID: game.ID(gameID)}, nil
+	return generateNumberRequest{GameID: game.ID(gameID), Locale: r.URL.Query().Get("locale")}, nil
 }
 
-func decodeGetAllNumbersRequest(_ context.Context, r *http.Request) (interface{}, error) {
+func decodeGetDrawsRequest(_ context.Context, r *http.Request) (interface{}, error) {
 	vars := mux.Vars(r)
 	gameID, ok := vars["gameId"]
 	if !ok {
 		return nil, errBadRoute
 	}
-	return getAllNumbersRequest{GameID: game.ID(gameID)}, nil
+	req := getDrawsRequest{GameID: game.ID(gameID), Locale: r.URL.Query().Get("locale")}
+	if v := r.URL.Query().Get("since"); v != "" {
+		since, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, ErrInvalidArgument
+		}
+		req.Since = since
+	}
+	return req, nil
 }
 
 func decodeGetStatsRequest(_ context.Context, r *http.Request) (interface{}, error) {
@@ -130,57 +346,433 @@ func decodeShowTicketRequest(_ context.Context, r *http.Request) (interface{}, e
 	return showTicketRequest{TicketID: ticket.ID(ticketID)}, nil
 }
 
+func decodeListTicketsRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	vars := mux.Vars(r)
+	gameID, ok := vars["gameId"]
+	if !ok {
+		return nil, errBadRoute
+	}
+	req := listTicketsRequest{GameID: game.ID(gameID)}
+	for param, v := range map[string]*int{"offset": &req.Offset, "limit": &req.Limit} {
+		s := r.URL.Query().Get(param)
+		if s == "" {
+			continue
+		}
+		n, err := strconv.Atoi(s)
+		if err != nil {
+			return nil, ErrInvalidArgument
+		}
+		*v = n
+	}
+	return req, nil
+}
+
+func decodeClaimPrizeRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	vars := mux.Vars(r)
+	gameID, ok := vars["gameId"]
+	if !ok {
+		return nil, errBadRoute
+	}
+	ticketID, ok := vars["ticketId"]
+	if !ok {
+		return nil, errBadRoute
+	}
+	var body struct {
+		Prize game.Prize `json:"prize"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return nil, ErrInvalidArgument
+	}
+	return claimPrizeRequest{GameID: game.ID(gameID), TicketID: ticket.ID(ticketID), Prize: body.Prize}, nil
+}
+
+// decodeMarkNumberRequest decodes requests to mark a number on a ticket, or to unmark it if marked is false.
+func decodeMarkNumberRequest(marked bool) kithttp.DecodeRequestFunc {
+	return func(_ context.Context, r *http.Request) (interface{}, error) {
+		vars := mux.Vars(r)
+		ticketID, ok := vars["ticketId"]
+		if !ok {
+			return nil, errBadRoute
+		}
+		number, err := strconv.ParseInt(vars["number"], 10, 64)
+		if err != nil {
+			return nil, ErrInvalidArgument
+		}
+		return markNumberRequest{TicketID: ticket.ID(ticketID), Number: number, Marked: marked}, nil
+	}
+}
+
+func decodeSetAutoDaubRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	vars := mux.Vars(r)
+	ticketID, ok := vars["ticketId"]
+	if !ok {
+		return nil, errBadRoute
+	}
+	var body struct {
+		Enabled bool `json:"enabled"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return nil, ErrInvalidArgument
+	}
+	return setAutoDaubRequest{TicketID: ticket.ID(ticketID), Enabled: body.Enabled}, nil
+}
+
+func decodeChangeStatusRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	vars := mux.Vars(r)
+	gameID, ok := vars["gameId"]
+	if !ok {
+		return nil, errBadRoute
+	}
+	return changeStatusRequest{GameID: game.ID(gameID)}, nil
+}
+
+// decodeAutoCallRequest returns a decoder for requests which start auto-calling a game when enabled, or stop it.
+func decodeAutoCallRequest(enabled bool) kithttp.DecodeRequestFunc {
+	return func(_ context.Context, r *http.Request) (interface{}, error) {
+		vars := mux.Vars(r)
+		gameID, ok := vars["gameId"]
+		if !ok {
+			return nil, errBadRoute
+		}
+		req := autoCallRequest{GameID: game.ID(gameID), Enabled: enabled, Interval: defaultAutoCallInterval}
+		if !enabled {
+			return req, nil
+		}
+		var body struct {
+			Interval string `json:"interval"`
+		}
+		// body is optional, numbers are drawn every 5 seconds without one
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil && err != io.EOF {
+			return nil, ErrInvalidArgument
+		}
+		if body.Interval != "" {
+			interval, err := time.ParseDuration(body.Interval)
+			if err != nil {
+				return nil, ErrInvalidArgument
+			}
+			req.Interval = interval
+		}
+		return req, nil
+	}
+}
+
+func decodeSetCallsRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	vars := mux.Vars(r)
+	gameID, ok := vars["gameId"]
+	if !ok {
+		return nil, errBadRoute
+	}
+	var body struct {
+		Locale string            `json:"locale"`
+		Names  map[string]string `json:"names"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return nil, ErrInvalidArgument
+	}
+	calls := game.Calls{Locale: body.Locale}
+	if len(body.Names) > 0 {
+		calls.Custom = make(map[int64]string, len(body.Names))
+		for n, name := range body.Names {
+			number, err := strconv.ParseInt(n, 10, 64)
+			if err != nil {
+				return nil, ErrInvalidArgument
+			}
+			calls.Custom[number] = name
+		}
+	}
+	return setCallsRequest{GameID: game.ID(gameID), Calls: calls}, nil
+}
+
+func decodeGetSeedRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	vars := mux.Vars(r)
+	gameID, ok := vars["gameId"]
+	if !ok {
+		return nil, errBadRoute
+	}
+	return getSeedRequest{GameID: game.ID(gameID)}, nil
+}
+
+// makeEventStreamHandler streams events of a game as Server-Sent Events.
+func makeEventStreamHandler(bs Service, errorHandler transport.ErrorHandler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		gameID, lastEventID, err := decodeWatchRequest(r)
+		if err != nil {
+			serveError(ctx, errorHandler, err, w)
+			return
+		}
+		flusher, ok := w.(http.Flusher)
+		if !ok {
+			serveError(ctx, errorHandler, errors.New("bingo: streaming unsupported"), w)
+			return
+		}
+		events, err := bs.Watch(ctx, gameID, lastEventID)
+		if err != nil {
+			serveError(ctx, errorHandler, err, w)
+			return
+		}
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Cache-Control", "no-cache")
+		w.Header().Set("Connection", "keep-alive")
+		w.WriteHeader(http.StatusOK)
+		flusher.Flush()
+		for e := range events {
+			data, err := json.Marshal(e)
+			if err != nil {
+				return
+			}
+			// events without an ID leave the last event ID of the client as it is
+			if e.ID > 0 {
+				if _, err := fmt.Fprintf(w, "id: %d\n", e.ID); err != nil {
+					return
+				}
+			}
+			if _, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", e.Type, data); err != nil {
+				return
+			}
+			flusher.Flush()
+		}
+	})
+}
+
+var upgrader = websocket.Upgrader{}
+
+// makeEventSocketHandler streams events of a game as JSON messages over a WebSocket.
+func makeEventSocketHandler(bs Service, errorHandler transport.ErrorHandler, logger kitlog.Logger) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx, cancel := context.WithCancel(r.Context())
+		defer cancel()
+		gameID, lastEventID, err := decodeWatchRequest(r)
+		if err != nil {
+			serveError(ctx, errorHandler, err, w)
+			return
+		}
+		events, err := bs.Watch(ctx, gameID, lastEventID)
+		if err != nil {
+			serveError(ctx, errorHandler, err, w)
+			return
+		}
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			logger.Log("err", err, "msg", "websocket upgrade failed")
+			return
+		}
+		defer conn.Close()
+		// stop watching once the client goes away, clients are not expected to send anything
+		go func() {
+			defer cancel()
+			for {
+				if _, _, err := conn.ReadMessage(); err != nil {
+					return
+				}
+			}
+		}()
+		for e := range events {
+			if err := conn.WriteJSON(e); err != nil {
+				return
+			}
+		}
+		conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+	})
+}
+
+// serveError writes err as problem details for handlers not served by go-kit, and has errorHandler log it.
+func serveError(ctx context.Context, errorHandler transport.ErrorHandler, err error, w http.ResponseWriter) {
+	errorHandler.Handle(ctx, err)
+	errs.EncodeError(ctx, err, w)
+}
+
+// decodeWatchRequest reads the last event ID seen by a client from the Last-Event-ID header,
+// or the last_event_id query param for clients which can not set headers.
+func decodeWatchRequest(r *http.Request) (game.ID, int64, error) {
+	vars := mux.Vars(r)
+	gameID, ok := vars["gameId"]
+	if !ok {
+		return "", 0, errBadRoute
+	}
+	last := r.Header.Get("Last-Event-ID")
+	if last == "" {
+		last = r.URL.Query().Get("last_event_id")
+	}
+	if last == "" {
+		return game.ID(gameID), 0, nil
+	}
+	lastEventID, err := strconv.ParseInt(last, 10, 64)
+	if err != nil {
+		return "", 0, ErrInvalidArgument
+	}
+	return game.ID(gameID), lastEventID, nil
+}
+
+// encodeShowTicketResponse renders a ticket as HTML table, JSON grid or plain text grid, as preferred by the Accept header.
 func encodeShowTicketResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
 	e, ok := response.(showTicketResponse)
 	if ok && e.error() != nil {
-		encodeError(ctx, e.error(), w)
-		return nil
+		return e.error()
+	}
+	t := e.Ticket
+	accept, _ := ctx.Value(kithttp.ContextKeyRequestAccept).(string)
+	w.Header().Set("Vary", "Accept")
+	switch negotiate(accept, mediaHTML, mediaJSON, mediaText) {
+	case mediaJSON:
+		return encodeResponse(ctx, w, ticketGrid{Rows: gridRows(t)})
+	case mediaText:
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		_, err := io.WriteString(w, encodeTextGrid(t))
+		return err
+	}
+	table := "<table style='table-layout:fixed' border='1px solid black';>"
+	if t.Username != "" {
+		// usernames of tickets bought before usernames were validated may contain markup
+		table += "<caption>" + html.EscapeString(t.Username) + "</caption>"
+	}
+	marked, drawn := numberSet(t.Marked), numberSet(t.Drawn)
+	for r := 0; r < t.Rows; r++ {
+		table += encodeTableRow(row(t, r), marked, drawn)
 	}
-	ticket := e.Ticket
-	cells := ticket.Cells
-	table := "<table style='table-layout:fixed' border='1px solid black';>" + encodeTableRow(cells[:9]) + encodeTableRow(cells[9:18]) + encodeTableRow(cells[18:]) + "</table>"
+	table += "</table>"
 
 	w.Header().Set("Content-Type", "text/html; charset=utf-8")
 	w.Write([]byte(table))
 	return nil
 }
 
-func encodeTableRow(row []int64) string {
+// ticketGrid is the JSON view of a ticket, null for cells without a number.
+type ticketGrid struct {
+	Rows [][]*int64 `json:"rows"`
+}
+
+func gridRows(t Ticket) [][]*int64 {
+	rows := make([][]*int64, t.Rows)
+	for r := range rows {
+		rows[r] = make([]*int64, t.Cols)
+		for c, n := range row(t, r) {
+			if n != ticket.EmptyCell && n != ticket.FreeCell {
+				n := n
+				rows[r][c] = &n
+			}
+		}
+	}
+	return rows
+}
+
+// encodeTextGrid renders a ticket as an ASCII table, marked numbers are followed by `*`.
+func encodeTextGrid(t Ticket) string {
+	marked := numberSet(t.Marked)
+	width := 4 // fits FREE and marked numbers up to 999
+	for _, n := range t.Cells {
+		if l := len(strconv.FormatInt(n, 10)) + 1; l > width {
+			width = l
+		}
+	}
+	line := "+" + strings.Repeat(strings.Repeat("-", width+2)+"+", t.Cols) + "\n"
+	var sb strings.Builder
+	sb.WriteString(line)
+	for r := 0; r < t.Rows; r++ {
+		sb.WriteString("|")
+		for _, n := range row(t, r) {
+			cell := ""
+			switch {
+			case n == ticket.EmptyCell:
+			case n == ticket.FreeCell:
+				cell = "FREE"
+			case marked[n]:
+				cell = strconv.FormatInt(n, 10) + "*"
+			default:
+				cell = strconv.FormatInt(n, 10)
+			}
+			sb.WriteString(" " + strings.Repeat(" ", width-len(cell)) + cell + " |")
+		}
+		sb.WriteString("\n" + line)
+	}
+	return sb.String()
+}
+
+// ticketDetails is the JSON view of a ticket served by the API, with state of each cell.
+type ticketDetails struct {
+	ID        ticket.ID      `json:"id"`
+	GameID    game.ID        `json:"game_id,omitempty"`
+	Username  string         `json:"username"`
+	StripID   ticket.StripID `json:"strip_id,omitempty"`
+	AutoDaub  bool           `json:"auto_daub"`
+	Rows      [][]ticketCell `json:"rows"`
+	CreatedAt time.Time      `json:"created_at"`
+	Format    *Format        `json:"format,omitempty"`
+	Nonce     string         `json:"nonce,omitempty"`
+}
+
+type ticketCell struct {
+	Number *int64 `json:"number"`
+	Free   bool   `json:"free,omitempty"`
+	Drawn  bool   `json:"drawn,omitempty"`
+	Marked bool   `json:"marked,omitempty"`
+}
+
+func encodeTicketResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	e, ok := response.(showTicketResponse)
+	if ok && e.error() != nil {
+		return e.error()
+	}
+	t := e.Ticket
+	marked, drawn := numberSet(t.Marked), numberSet(t.Drawn)
+	details := ticketDetails{
+		ID:        t.ID,
+		GameID:    t.GameID,
+		Username:  t.Username,
+		StripID:   t.StripID,
+		AutoDaub:  t.AutoDaub,
+		Rows:      make([][]ticketCell, t.Rows),
+		CreatedAt: t.CreatedAt,
+		Format:    t.Format,
+		Nonce:     t.Nonce,
+	}
+	for r, numbers := range gridRows(t) {
+		details.Rows[r] = make([]ticketCell, t.Cols)
+		for c, n := range numbers {
+			cell := ticketCell{Number: n, Free: row(t, r)[c] == ticket.FreeCell}
+			if n != nil {
+				cell.Drawn, cell.Marked = drawn[*n], marked[*n]
+			}
+			details.Rows[r][c] = cell
+		}
+	}
+	return encodeResponse(ctx, w, details)
+}
+
+// encodeTableRow renders a row of a ticket, marked cells are highlighted and cells drawn but not marked are in bold red.
+func encodeTableRow(row []int64, marked, drawn map[int64]bool) string {
+	width := "width: " + strconv.Itoa(100/len(row)) + "%;"
+	td := "<td style='" + width + "'>"
 	tds := ""
 	for _, r := range row {
-		if r != -1 {
-			tds = tds + "<td style='width: 11%;'>" + strconv.FormatInt(r, 10) + "</td>"
-		} else {
-			tds = tds + "<td style='width: 11%;'></td>"
+		switch {
+		case r == ticket.EmptyCell:
+			tds = tds + td + "</td>"
+		case r == ticket.FreeCell:
+			tds = tds + td + "FREE</td>"
+		case marked[r]:
+			tds = tds + "<td style='" + width + " background-color: #ffd54f;'>" + strconv.FormatInt(r, 10) + "</td>"
+		case drawn[r]:
+			tds = tds + "<td style='" + width + " color: #d32f2f; font-weight: bold;'>" + strconv.FormatInt(r, 10) + "</td>"
+		default:
+			tds = tds + td + strconv.FormatInt(r, 10) + "</td>"
 		}
 	}
 	return "<tr>" + tds + "</tr>"
 }
 
-// encode errors from business-logic
-func encodeError(_ context.Context, err error, w http.ResponseWriter) {
-	w.Header().Set("Content-Type", "application/json; charset=utf-8")
-	switch err {
-	case game.ErrInvalidID:
-		w.WriteHeader(http.StatusBadRequest)
-	case ticket.ErrInvalidID:
-		w.WriteHeader(http.StatusBadRequest)
-	case ErrMaxNumbersGenerated:
-		w.WriteHeader(http.StatusBadRequest)
-	case ErrInvalidArgument:
-		w.WriteHeader(http.StatusBadRequest)
-	default:
-		w.WriteHeader(http.StatusInternalServerError)
-	}
-	json.NewEncoder(w).Encode(map[string]interface{}{
-		"error": err.Error(),
-	})
+func numberSet(numbers []int64) map[int64]bool {
+	set := make(map[int64]bool, len(numbers))
+	for _, n := range numbers {
+		set[n] = true
+	}
+	return set
 }
 
+// encodeResponse encodes response as JSON, errors from business-logic are returned for the server to encode.
 func encodeResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
 	if e, ok := response.(errorer); ok && e.error() != nil {
-		encodeError(ctx, e.error(), w)
-		return nil
+		return e.error()
 	}
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	return json.NewEncoder(w).Encode(response)
@@ -0,0 +1,802 @@
+package bingo
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/divyanshgaba/bingo/account"
+	"github.com/divyanshgaba/bingo/errs"
+	"github.com/divyanshgaba/bingo/game"
+	"github.com/divyanshgaba/bingo/pb"
+	"github.com/divyanshgaba/bingo/ticket"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/go-kit/kit/endpoint"
+	kitlog "github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/transport"
+	kitgrpc "github.com/go-kit/kit/transport/grpc"
+)
+
+// HostSecretMetadata is the gRPC metadata key the host secret of a game is passed in, for host operations.
+const HostSecretMetadata = "x-host-secret"
+
+// hostSecretFromMetadata moves the host secret of a gRPC call into the context.
+func hostSecretFromMetadata(ctx context.Context, md metadata.MD) context.Context {
+	if v := md.Get(HostSecretMetadata); len(v) > 0 {
+		return context.WithValue(ctx, hostSecretKey, v[0])
+	}
+	return ctx
+}
+
+type grpcServer struct {
+	bs           Service
+	errorHandler transport.ErrorHandler
+
+	createGame        kitgrpc.Handler
+	authorizeHost     kitgrpc.Handler
+	createTicket      kitgrpc.Handler
+	createTicketStrip kitgrpc.Handler
+	showTicket        kitgrpc.Handler
+	listTickets       kitgrpc.Handler
+	markNumber        kitgrpc.Handler
+	unmarkNumber      kitgrpc.Handler
+	setAutoDaub       kitgrpc.Handler
+	generateNumber    kitgrpc.Handler
+	getDraws          kitgrpc.Handler
+	setCalls          kitgrpc.Handler
+	getStats          kitgrpc.Handler
+	claimPrize        kitgrpc.Handler
+	startGame         kitgrpc.Handler
+	pauseGame         kitgrpc.Handler
+	resumeGame        kitgrpc.Handler
+	endGame           kitgrpc.Handler
+	getSeed           kitgrpc.Handler
+	startAutoCall     kitgrpc.Handler
+	stopAutoCall      kitgrpc.Handler
+}
+
+// MakeGRPCServer returns a gRPC server for the bingo service. Calls which act as a player are authenticated with as.
+func MakeGRPCServer(bs Service, as account.Service, logger kitlog.Logger) pb.BingoServer {
+	errorHandler := errs.NewLogErrorHandler(logger)
+	opts := []kitgrpc.ServerOption{
+		kitgrpc.ServerErrorHandler(errorHandler),
+		kitgrpc.ServerBefore(account.GRPCToContext, hostSecretFromMetadata),
+	}
+	authenticate := account.Authenticate(as)
+	// host operations on games created before host secrets are authorized by the player hosting them
+	identify := account.Identify(as)
+	host := endpoint.Chain(identify, requireHost(bs))
+	return &grpcServer{
+		bs:           bs,
+		errorHandler: errorHandler,
+		createGame: kitgrpc.NewServer(
+			identify(makeCreateGameEndpoint(bs)),
+			decodeGRPCCreateGameRequest,
+			encodeGRPCCreateGameResponse,
+			opts...,
+		),
+		authorizeHost: kitgrpc.NewServer(
+			identify(makeAuthorizeHostEndpoint(bs)),
+			decodeGRPCAuthorizeHostRequest,
+			encodeGRPCAuthorizeHostResponse,
+			opts...,
+		),
+		createTicket: kitgrpc.NewServer(
+			authenticate(makeCreateTicketEndpoint(bs)),
+			decodeGRPCCreateTicketRequest,
+			encodeGRPCCreateTicketResponse,
+			opts...,
+		),
+		createTicketStrip: kitgrpc.NewServer(
+			authenticate(makeCreateTicketStripEndpoint(bs)),
+			decodeGRPCCreateTicketStripRequest,
+			encodeGRPCCreateTicketStripResponse,
+			opts...,
+		),
+		showTicket: kitgrpc.NewServer(
+			authenticate(makeShowTicketEndpoint(bs)),
+			decodeGRPCShowTicketRequest,
+			encodeGRPCShowTicketResponse,
+			opts...,
+		),
+		listTickets: kitgrpc.NewServer(
+			makeListTicketsEndpoint(bs),
+			decodeGRPCListTicketsRequest,
+			encodeGRPCListTicketsResponse,
+			opts...,
+		),
+		markNumber: kitgrpc.NewServer(
+			authenticate(makeMarkNumberEndpoint(bs)),
+			decodeGRPCMarkNumberRequest(true),
+			encodeGRPCMarkNumberResponse,
+			opts...,
+		),
+		unmarkNumber: kitgrpc.NewServer(
+			authenticate(makeMarkNumberEndpoint(bs)),
+			decodeGRPCMarkNumberRequest(false),
+			encodeGRPCMarkNumberResponse,
+			opts...,
+		),
+		setAutoDaub: kitgrpc.NewServer(
+			authenticate(makeSetAutoDaubEndpoint(bs)),
+			decodeGRPCSetAutoDaubRequest,
+			encodeGRPCSetAutoDaubResponse,
+			opts...,
+		),
+		generateNumber: kitgrpc.NewServer(
+			host(makeGenerateNumberEndpoint(bs)),
+			decodeGRPCGenerateNumberRequest,
+			encodeGRPCGenerateNumberResponse,
+			opts...,
+		),
+		getDraws: kitgrpc.NewServer(
+			makeGetDrawsEndpoint(bs),
+			decodeGRPCGetDrawsRequest,
+			encodeGRPCGetDrawsResponse,
+			opts...,
+		),
+		setCalls: kitgrpc.NewServer(
+			host(makeSetCallsEndpoint(bs)),
+			decodeGRPCSetCallsRequest,
+			encodeGRPCSetCallsResponse,
+			opts...,
+		),
+		getStats: kitgrpc.NewServer(
+			makeGetStatsEndpoint(bs),
+			decodeGRPCGetStatsRequest,
+			encodeGRPCGetStatsResponse,
+			opts...,
+		),
+		claimPrize: kitgrpc.NewServer(
+			authenticate(makeClaimPrizeEndpoint(bs)),
+			decodeGRPCClaimPrizeRequest,
+			encodeGRPCClaimPrizeResponse,
+			opts...,
+		),
+		startGame: kitgrpc.NewServer(
+			host(makeStartGameEndpoint(bs)),
+			decodeGRPCChangeStatusRequest,
+			encodeGRPCChangeStatusResponse,
+			opts...,
+		),
+		pauseGame: kitgrpc.NewServer(
+			host(makePauseGameEndpoint(bs)),
+			decodeGRPCChangeStatusRequest,
+			encodeGRPCChangeStatusResponse,
+			opts...,
+		),
+		resumeGame: kitgrpc.NewServer(
+			host(makeResumeGameEndpoint(bs)),
+			decodeGRPCChangeStatusRequest,
+			encodeGRPCChangeStatusResponse,
+			opts...,
+		),
+		endGame: kitgrpc.NewServer(
+			host(makeEndGameEndpoint(bs)),
+			decodeGRPCChangeStatusRequest,
+			encodeGRPCChangeStatusResponse,
+			opts...,
+		),
+		getSeed: kitgrpc.NewServer(
+			makeGetSeedEndpoint(bs),
+			decodeGRPCGetSeedRequest,
+			encodeGRPCGetSeedResponse,
+			opts...,
+		),
+		startAutoCall: kitgrpc.NewServer(
+			host(makeAutoCallEndpoint(bs)),
+			decodeGRPCStartAutoCallRequest,
+			encodeGRPCAutoCallResponse,
+			opts...,
+		),
+		stopAutoCall: kitgrpc.NewServer(
+			host(makeAutoCallEndpoint(bs)),
+			decodeGRPCStopAutoCallRequest,
+			encodeGRPCAutoCallResponse,
+			opts...,
+		),
+	}
+}
+
+// serve calls h, and converts errors from business-logic into gRPC status errors.
+func serve(ctx context.Context, h kitgrpc.Handler, req interface{}) (interface{}, error) {
+	_, resp, err := h.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return resp, nil
+}
+
+func (s *grpcServer) CreateGame(ctx context.Context, req *pb.CreateGameRequest) (*pb.CreateGameReply, error) {
+	resp, err := serve(ctx, s.createGame, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*pb.CreateGameReply), nil
+}
+
+func (s *grpcServer) AuthorizeHost(ctx context.Context, req *pb.AuthorizeHostRequest) (*pb.AuthorizeHostReply, error) {
+	resp, err := serve(ctx, s.authorizeHost, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*pb.AuthorizeHostReply), nil
+}
+
+func (s *grpcServer) CreateTicket(ctx context.Context, req *pb.CreateTicketRequest) (*pb.CreateTicketReply, error) {
+	resp, err := serve(ctx, s.createTicket, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*pb.CreateTicketReply), nil
+}
+
+func (s *grpcServer) CreateTicketStrip(ctx context.Context, req *pb.CreateTicketRequest) (*pb.CreateTicketStripReply, error) {
+	resp, err := serve(ctx, s.createTicketStrip, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*pb.CreateTicketStripReply), nil
+}
+
+func (s *grpcServer) ShowTicket(ctx context.Context, req *pb.ShowTicketRequest) (*pb.Ticket, error) {
+	resp, err := serve(ctx, s.showTicket, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*pb.Ticket), nil
+}
+
+func (s *grpcServer) ListTickets(ctx context.Context, req *pb.ListTicketsRequest) (*pb.ListTicketsReply, error) {
+	resp, err := serve(ctx, s.listTickets, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*pb.ListTicketsReply), nil
+}
+
+func (s *grpcServer) MarkNumber(ctx context.Context, req *pb.MarkNumberRequest) (*pb.MarkNumberReply, error) {
+	resp, err := serve(ctx, s.markNumber, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*pb.MarkNumberReply), nil
+}
+
+func (s *grpcServer) UnmarkNumber(ctx context.Context, req *pb.MarkNumberRequest) (*pb.MarkNumberReply, error) {
+	resp, err := serve(ctx, s.unmarkNumber, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*pb.MarkNumberReply), nil
+}
+
+func (s *grpcServer) SetAutoDaub(ctx context.Context, req *pb.SetAutoDaubRequest) (*pb.SetAutoDaubReply, error) {
+	resp, err := serve(ctx, s.setAutoDaub, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*pb.SetAutoDaubReply), nil
+}
+
+func (s *grpcServer) GenerateNumber(ctx context.Context, req *pb.GenerateNumberRequest) (*pb.Draw, error) {
+	resp, err := serve(ctx, s.generateNumber, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*pb.Draw), nil
+}
+
+func (s *grpcServer) GetDraws(ctx context.Context, req *pb.GetDrawsRequest) (*pb.GetDrawsReply, error) {
+	resp, err := serve(ctx, s.getDraws, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*pb.GetDrawsReply), nil
+}
+
+func (s *grpcServer) SetCalls(ctx context.Context, req *pb.SetCallsRequest) (*pb.SetCallsReply, error) {
+	resp, err := serve(ctx, s.setCalls, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*pb.SetCallsReply), nil
+}
+
+func (s *grpcServer) GetStats(ctx context.Context, req *pb.GameRequest) (*pb.Stats, error) {
+	resp, err := serve(ctx, s.getStats, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*pb.Stats), nil
+}
+
+func (s *grpcServer) ClaimPrize(ctx context.Context, req *pb.ClaimPrizeRequest) (*pb.ClaimPrizeReply, error) {
+	resp, err := serve(ctx, s.claimPrize, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*pb.ClaimPrizeReply), nil
+}
+
+// WatchGame streams events of a game until the client goes away or falls behind.
+func (s *grpcServer) WatchGame(req *pb.WatchGameRequest, stream pb.Bingo_WatchGameServer) error {
+	events, err := s.bs.Watch(stream.Context(), game.ID(req.GameId), req.LastEventId)
+	if err != nil {
+		s.errorHandler.Handle(stream.Context(), err)
+		return grpcError(err)
+	}
+	for e := range events {
+		if err := stream.Send(encodeGRPCEvent(e)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *grpcServer) StartGame(ctx context.Context, req *pb.GameRequest) (*pb.StatusReply, error) {
+	resp, err := serve(ctx, s.startGame, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*pb.StatusReply), nil
+}
+
+func (s *grpcServer) PauseGame(ctx context.Context, req *pb.GameRequest) (*pb.StatusReply, error) {
+	resp, err := serve(ctx, s.pauseGame, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*pb.StatusReply), nil
+}
+
+func (s *grpcServer) ResumeGame(ctx context.Context, req *pb.GameRequest) (*pb.StatusReply, error) {
+	resp, err := serve(ctx, s.resumeGame, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*pb.StatusReply), nil
+}
+
+func (s *grpcServer) EndGame(ctx context.Context, req *pb.GameRequest) (*pb.StatusReply, error) {
+	resp, err := serve(ctx, s.endGame, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*pb.StatusReply), nil
+}
+
+func (s *grpcServer) GetSeed(ctx context.Context, req *pb.GameRequest) (*pb.GetSeedReply, error) {
+	resp, err := serve(ctx, s.getSeed, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*pb.GetSeedReply), nil
+}
+
+func (s *grpcServer) StartAutoCall(ctx context.Context, req *pb.StartAutoCallRequest) (*pb.AutoCallReply, error) {
+	resp, err := serve(ctx, s.startAutoCall, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*pb.AutoCallReply), nil
+}
+
+func (s *grpcServer) StopAutoCall(ctx context.Context, req *pb.GameRequest) (*pb.AutoCallReply, error) {
+	resp, err := serve(ctx, s.stopAutoCall, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*pb.AutoCallReply), nil
+}
+
+func decodeGRPCCreateGameRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.CreateGameRequest)
+	return createGameRequest{Format: game.Format{
+		Name:    req.Format,
+		Rows:    int(req.Rows),
+		Cols:    int(req.Cols),
+		Min:     req.Min,
+		Max:     req.Max,
+		Numbers: int(req.Numbers),
+	}, Rules: game.Rules{
+		MaxTicketsPerUser: int(req.MaxTicketsPerUser),
+		MaxTickets:        int(req.MaxTickets),
+		SalesCutoff:       game.SalesCutoff(req.SalesCutoff),
+	}}, nil
+}
+
+func decodeGRPCAuthorizeHostRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.AuthorizeHostRequest)
+	return authorizeHostRequest{GameID: game.ID(req.GameId), Secret: req.HostSecret}, nil
+}
+
+func decodeGRPCCreateTicketRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.CreateTicketRequest)
+	return createTicketRequest{GameID: game.ID(req.GameId), Username: req.Username}, nil
+}
+
+func decodeGRPCCreateTicketStripRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.CreateTicketRequest)
+	return createTicketStripRequest{GameID: game.ID(req.GameId), Username: req.Username}, nil
+}
+
+func decodeGRPCShowTicketRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.ShowTicketRequest)
+	return showTicketRequest{TicketID: ticket.ID(req.TicketId)}, nil
+}
+
+func decodeGRPCListTicketsRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.ListTicketsRequest)
+	return listTicketsRequest{GameID: game.ID(req.GameId), Offset: int(req.Offset), Limit: int(req.Limit)}, nil
+}
+
+// decodeGRPCMarkNumberRequest decodes calls to mark a number on a ticket, or to unmark it if marked is false.
+func decodeGRPCMarkNumberRequest(marked bool) kitgrpc.DecodeRequestFunc {
+	return func(_ context.Context, grpcReq interface{}) (interface{}, error) {
+		req := grpcReq.(*pb.MarkNumberRequest)
+		return markNumberRequest{TicketID: ticket.ID(req.TicketId), Number: req.Number, Marked: marked}, nil
+	}
+}
+
+func decodeGRPCSetAutoDaubRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.SetAutoDaubRequest)
+	return setAutoDaubRequest{TicketID: ticket.ID(req.TicketId), Enabled: req.Enabled}, nil
+}
+
+func decodeGRPCGenerateNumberRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.GenerateNumberRequest)
+	return generateNumberRequest{GameID: game.ID(req.GameId), Locale: req.Locale}, nil
+}
+
+func decodeGRPCGetDrawsRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.GetDrawsRequest)
+	return getDrawsRequest{GameID: game.ID(req.GameId), Since: int(req.Since), Locale: req.Locale}, nil
+}
+
+func decodeGRPCSetCallsRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.SetCallsRequest)
+	calls := game.Calls{Locale: req.Locale}
+	if len(req.Names) > 0 {
+		calls.Custom = req.Names
+	}
+	return setCallsRequest{GameID: game.ID(req.GameId), Calls: calls}, nil
+}
+
+func decodeGRPCGetStatsRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.GameRequest)
+	return getStatsRequest{GameID: game.ID(req.GameId)}, nil
+}
+
+func decodeGRPCClaimPrizeRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.ClaimPrizeRequest)
+	return claimPrizeRequest{GameID: game.ID(req.GameId), TicketID: ticket.ID(req.TicketId), Prize: game.Prize(req.Prize)}, nil
+}
+
+func decodeGRPCChangeStatusRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.GameRequest)
+	return changeStatusRequest{GameID: game.ID(req.GameId)}, nil
+}
+
+func decodeGRPCGetSeedRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.GameRequest)
+	return getSeedRequest{GameID: game.ID(req.GameId)}, nil
+}
+
+func decodeGRPCStartAutoCallRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.StartAutoCallRequest)
+	interval := defaultAutoCallInterval
+	if req.Interval != nil {
+		d, err := ptypes.Duration(req.Interval)
+		if err != nil {
+			return nil, ErrInvalidArgument
+		}
+		interval = d
+	}
+	return autoCallRequest{GameID: game.ID(req.GameId), Enabled: true, Interval: interval}, nil
+}
+
+func decodeGRPCStopAutoCallRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.GameRequest)
+	return autoCallRequest{GameID: game.ID(req.GameId)}, nil
+}
+
+func encodeGRPCCreateGameResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(createGameResponse)
+	if resp.Err != nil {
+		return nil, resp.Err
+	}
+	return &pb.CreateGameReply{GameId: string(resp.GameID), HostSecret: resp.HostSecret}, nil
+}
+
+func encodeGRPCAuthorizeHostResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(authorizeHostResponse)
+	if resp.Err != nil {
+		return nil, resp.Err
+	}
+	return &pb.AuthorizeHostReply{}, nil
+}
+
+func encodeGRPCCreateTicketResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(createTicketResponse)
+	if resp.Err != nil {
+		return nil, resp.Err
+	}
+	return &pb.CreateTicketReply{TicketId: string(resp.TicketID)}, nil
+}
+
+func encodeGRPCCreateTicketStripResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(createTicketStripResponse)
+	if resp.Err != nil {
+		return nil, resp.Err
+	}
+	ids := make([]string, len(resp.TicketIDs))
+	for i, id := range resp.TicketIDs {
+		ids[i] = string(id)
+	}
+	return &pb.CreateTicketStripReply{StripId: string(resp.StripID), TicketIds: ids}, nil
+}
+
+func encodeGRPCShowTicketResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(showTicketResponse)
+	if resp.Err != nil {
+		return nil, resp.Err
+	}
+	t := resp.Ticket
+	var format *pb.Format
+	if t.Format != nil {
+		format = &pb.Format{
+			Name:    t.Format.Name,
+			Rows:    int32(t.Format.Rows),
+			Cols:    int32(t.Format.Cols),
+			Min:     t.Format.Min,
+			Max:     t.Format.Max,
+			Numbers: int32(t.Format.Numbers),
+		}
+	}
+	return &pb.Ticket{
+		Id:        string(t.ID),
+		GameId:    string(t.GameID),
+		StripId:   string(t.StripID),
+		Username:  t.Username,
+		Rows:      int32(t.Rows),
+		Cols:      int32(t.Cols),
+		Cells:     t.Cells,
+		Marked:    t.Marked,
+		Drawn:     t.Drawn,
+		AutoDaub:  t.AutoDaub,
+		CreatedAt: timestampProto(t.CreatedAt),
+		Format:    format,
+		Nonce:     t.Nonce,
+	}, nil
+}
+
+func encodeGRPCListTicketsResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(listTicketsResponse)
+	if resp.Err != nil {
+		return nil, resp.Err
+	}
+	listings := make([]*pb.ListTicketsReply_Listing, len(resp.Tickets))
+	for i, t := range resp.Tickets {
+		listings[i] = &pb.ListTicketsReply_Listing{
+			TicketId:  string(t.TicketID),
+			Username:  t.Username,
+			StripId:   string(t.StripID),
+			CreatedAt: timestampProto(t.CreatedAt),
+			Rows:      int32(t.Rows),
+			Cols:      int32(t.Cols),
+			Cells:     t.Cells,
+			Marked:    t.Marked,
+			AutoDaub:  t.AutoDaub,
+		}
+	}
+	return &pb.ListTicketsReply{Tickets: listings, Total: int32(resp.Total), Offset: int32(resp.Offset)}, nil
+}
+
+func encodeGRPCMarkNumberResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(markNumberResponse)
+	if resp.Err != nil {
+		return nil, resp.Err
+	}
+	return &pb.MarkNumberReply{Number: resp.Number, Marked: resp.Marked}, nil
+}
+
+func encodeGRPCSetAutoDaubResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(setAutoDaubResponse)
+	if resp.Err != nil {
+		return nil, resp.Err
+	}
+	return &pb.SetAutoDaubReply{Enabled: resp.Enabled}, nil
+}
+
+func encodeGRPCGenerateNumberResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(generateNumberResponse)
+	if resp.Err != nil {
+		return nil, resp.Err
+	}
+	draw := &pb.Draw{Seq: int32(resp.Seq), Number: resp.Number, Caller: resp.Caller, Announcement: resp.Announcement}
+	if resp.DrawnAt != nil {
+		draw.DrawnAt = timestampProto(*resp.DrawnAt)
+	}
+	return draw, nil
+}
+
+func encodeGRPCGetDrawsResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(getDrawsResponse)
+	if resp.Err != nil {
+		return nil, resp.Err
+	}
+	draws := make([]*pb.Draw, len(resp.Draws))
+	for i, d := range resp.Draws {
+		draws[i] = &pb.Draw{Seq: int32(d.Seq), Number: d.Number, Caller: d.Caller, Announcement: d.Announcement}
+		if d.DrawnAt != nil {
+			draws[i].DrawnAt = timestampProto(*d.DrawnAt)
+		}
+	}
+	return &pb.GetDrawsReply{Draws: draws}, nil
+}
+
+func encodeGRPCSetCallsResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(setCallsResponse)
+	if resp.Err != nil {
+		return nil, resp.Err
+	}
+	return &pb.SetCallsReply{Locale: resp.Locale}, nil
+}
+
+func encodeGRPCGetStatsResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(getStatsResponse)
+	if resp.Err != nil {
+		return nil, resp.Err
+	}
+	stats := &pb.Stats{
+		NumbersDrawn:     int32(resp.NumbersDrawn),
+		NumbersRemaining: int32(resp.NumbersRemaining),
+		TicketsGenerated: int32(resp.TicketsGenerated),
+		Players:          int32(resp.Players),
+		DrawsPerMinute:   resp.DrawsPerMinute,
+		Prizes:           make([]*pb.Stats_PrizeStatus, len(resp.Prizes)),
+		Leaderboard:      make([]*pb.Stats_Standing, len(resp.Leaderboard)),
+	}
+	if resp.FirstDrawnAt != nil && resp.LastDrawnAt != nil {
+		stats.FirstDrawnAt, stats.LastDrawnAt = timestampProto(*resp.FirstDrawnAt), timestampProto(*resp.LastDrawnAt)
+	}
+	for i, p := range resp.Prizes {
+		stats.Prizes[i] = &pb.Stats_PrizeStatus{Prize: string(p.Prize), Claimed: p.Claimed, TicketId: string(p.TicketID)}
+	}
+	for i, s := range resp.Leaderboard {
+		stats.Leaderboard[i] = &pb.Stats_Standing{
+			TicketId:      string(s.TicketID),
+			Username:      s.Username,
+			FullHouseAway: int32(s.FullHouseAway),
+			LineAway:      int32(s.LineAway),
+		}
+	}
+	return stats, nil
+}
+
+func encodeGRPCClaimPrizeResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(claimPrizeResponse)
+	if resp.Err != nil {
+		return nil, resp.Err
+	}
+	return &pb.ClaimPrizeReply{Prize: string(resp.Prize), TicketId: string(resp.TicketID)}, nil
+}
+
+func encodeGRPCChangeStatusResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(changeStatusResponse)
+	if resp.Err != nil {
+		return nil, resp.Err
+	}
+	return &pb.StatusReply{Status: string(resp.Status)}, nil
+}
+
+func encodeGRPCGetSeedResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(getSeedResponse)
+	if resp.Err != nil {
+		return nil, resp.Err
+	}
+	return &pb.GetSeedReply{SeedHash: resp.SeedHash, Seed: resp.Seed}, nil
+}
+
+func encodeGRPCAutoCallResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(autoCallResponse)
+	if resp.Err != nil {
+		return nil, resp.Err
+	}
+	reply := &pb.AutoCallReply{Enabled: resp.Enabled}
+	if interval, err := time.ParseDuration(resp.Interval); err == nil {
+		reply.Interval = ptypes.DurationProto(interval)
+	}
+	return reply, nil
+}
+
+func encodeGRPCEvent(e Event) *pb.Event {
+	event := &pb.Event{Id: e.ID, Type: string(e.Type), GameId: string(e.GameID), TicketId: string(e.TicketID)}
+	if e.Number != nil {
+		event.Number = *e.Number
+	}
+	return event
+}
+
+// timestampProto converts t to a protobuf timestamp, nil for the zero time.
+func timestampProto(t time.Time) *timestamp.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	ts, err := ptypes.TimestampProto(t)
+	if err != nil {
+		return nil
+	}
+	return ts
+}
+
+// grpcError converts errors into gRPC status errors, with the code matching the HTTP status the error is served
+// with. Like over HTTP, errors not from business-logic are only described as internal. The code of the error is
+// sent as the reason of an ErrorInfo detail, and invalid fields as BadRequest details.
+func grpcError(err error) error {
+	e := errs.From(err)
+	code := codes.Internal
+	switch e.Status {
+	case http.StatusBadRequest:
+		code = codes.InvalidArgument
+	case http.StatusUnauthorized:
+		code = codes.Unauthenticated
+	case http.StatusForbidden:
+		code = codes.PermissionDenied
+	case http.StatusNotFound:
+		code = codes.NotFound
+	case http.StatusConflict, http.StatusUnprocessableEntity:
+		code = codes.FailedPrecondition
+	}
+	details := []proto.Message{&errdetails.ErrorInfo{Reason: e.Code}}
+	if len(e.Fields) > 0 {
+		br := &errdetails.BadRequest{}
+		for _, f := range e.Fields {
+			br.FieldViolations = append(br.FieldViolations, &errdetails.BadRequest_FieldViolation{Field: f.Name, Description: f.Reason})
+		}
+		details = append(details, br)
+	}
+	st := status.New(code, e.Message)
+	if detailed, err := st.WithDetails(details...); err == nil {
+		st = detailed
+	}
+	return st.Err()
+}
+
+// DecodeGRPCError returns the error a gRPC status error was converted from by the gRPC server, so gRPC clients
+// can tell errors apart by their code like HTTP clients do. Other errors, and errors with codes not known to the
+// client, are returned as they are.
+func DecodeGRPCError(err error) error {
+	st, ok := status.FromError(err)
+	if !ok || err == nil {
+		return err
+	}
+	var code string
+	var fields []errs.Field
+	for _, d := range st.Details() {
+		switch d := d.(type) {
+		case *errdetails.ErrorInfo:
+			code = d.Reason
+		case *errdetails.BadRequest:
+			for _, v := range d.FieldViolations {
+				fields = append(fields, errs.Field{Name: v.Field, Reason: v.Description})
+			}
+		}
+	}
+	e, ok := errs.Lookup(code)
+	if !ok {
+		return err
+	}
+	if len(fields) > 0 {
+		return e.WithFields(fields...)
+	}
+	return e
+}
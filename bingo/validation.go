@@ -0,0 +1,212 @@
+package bingo
+
+import (
+	"context"
+	"time"
+
+	"github.com/divyanshgaba/bingo/errs"
+	"github.com/divyanshgaba/bingo/game"
+	"github.com/divyanshgaba/bingo/player"
+	"github.com/divyanshgaba/bingo/ticket"
+)
+
+// reasons an ID is invalid
+const (
+	reasonGameID   = "must be an ID of a game, 24 hexadecimal characters"
+	reasonTicketID = "must be an ID of a ticket, 24 hexadecimal characters"
+)
+
+type validatingService struct {
+	next Service
+}
+
+// NewValidatingService returns a Service which checks arguments before passing them to s, so malformed IDs never
+// reach a repository. Usernames are normalized, see player.NormalizeUsername. Invalid arguments fail with
+// ErrInvalidArgument, which tells clients about each invalid field.
+func NewValidatingService(s Service) Service {
+	return validatingService{next: s}
+}
+
+// validate returns ErrInvalidArgument with fields, if there are any.
+func validate(fields ...errs.Field) error {
+	if len(fields) == 0 {
+		return nil
+	}
+	return ErrInvalidArgument.WithFields(fields...)
+}
+
+func checkGameID(id game.ID) []errs.Field {
+	if id.Valid() {
+		return nil
+	}
+	return []errs.Field{{Name: "game_id", Reason: reasonGameID}}
+}
+
+func checkTicketID(id ticket.ID) []errs.Field {
+	if id.Valid() {
+		return nil
+	}
+	return []errs.Field{{Name: "ticket_id", Reason: reasonTicketID}}
+}
+
+// checkUsername returns username normalized, and the field if it is invalid.
+func checkUsername(username string) (string, []errs.Field) {
+	username, err := player.NormalizeUsername(username)
+	if err != nil {
+		return "", []errs.Field{{Name: "username", Reason: err.Error()}}
+	}
+	return username, nil
+}
+
+func (s validatingService) CreateGame(ctx context.Context, f game.Format, r game.Rules) (game.ID, string, error) {
+	return s.next.CreateGame(ctx, f, r)
+}
+
+func (s validatingService) AuthorizeHost(ctx context.Context, gameID game.ID, secret string) error {
+	if err := validate(checkGameID(gameID)...); err != nil {
+		return err
+	}
+	return s.next.AuthorizeHost(ctx, gameID, secret)
+}
+
+func (s validatingService) CreateTicket(ctx context.Context, gameID game.ID, username string) (ticket.ID, error) {
+	username, fields := checkUsername(username)
+	if err := validate(append(checkGameID(gameID), fields...)...); err != nil {
+		return "", err
+	}
+	return s.next.CreateTicket(ctx, gameID, username)
+}
+
+func (s validatingService) CreateTicketStrip(ctx context.Context, gameID game.ID, username string) (ticket.StripID, []ticket.ID, error) {
+	username, fields := checkUsername(username)
+	if err := validate(append(checkGameID(gameID), fields...)...); err != nil {
+		return "", nil, err
+	}
+	return s.next.CreateTicketStrip(ctx, gameID, username)
+}
+
+func (s validatingService) ShowTicket(ctx context.Context, id ticket.ID) (Ticket, error) {
+	if err := validate(checkTicketID(id)...); err != nil {
+		return Ticket{}, err
+	}
+	return s.next.ShowTicket(ctx, id)
+}
+
+func (s validatingService) ListTickets(ctx context.Context, gameID game.ID, offset, limit int) ([]Ticket, int, error) {
+	if err := validate(checkGameID(gameID)...); err != nil {
+		return nil, 0, err
+	}
+	return s.next.ListTickets(ctx, gameID, offset, limit)
+}
+
+func (s validatingService) MarkNumber(ctx context.Context, id ticket.ID, number int64) error {
+	if err := validate(checkTicketID(id)...); err != nil {
+		return err
+	}
+	return s.next.MarkNumber(ctx, id, number)
+}
+
+func (s validatingService) UnmarkNumber(ctx context.Context, id ticket.ID, number int64) error {
+	if err := validate(checkTicketID(id)...); err != nil {
+		return err
+	}
+	return s.next.UnmarkNumber(ctx, id, number)
+}
+
+func (s validatingService) SetAutoDaub(ctx context.Context, id ticket.ID, enabled bool) error {
+	if err := validate(checkTicketID(id)...); err != nil {
+		return err
+	}
+	return s.next.SetAutoDaub(ctx, id, enabled)
+}
+
+func (s validatingService) GenerateNumber(ctx context.Context, gameID game.ID, locale string) (Draw, error) {
+	if err := validate(checkGameID(gameID)...); err != nil {
+		return Draw{}, err
+	}
+	return s.next.GenerateNumber(ctx, gameID, locale)
+}
+
+func (s validatingService) GetDraws(ctx context.Context, gameID game.ID, since int, locale string) ([]Draw, error) {
+	if err := validate(checkGameID(gameID)...); err != nil {
+		return nil, err
+	}
+	return s.next.GetDraws(ctx, gameID, since, locale)
+}
+
+func (s validatingService) SetCalls(ctx context.Context, gameID game.ID, c game.Calls) error {
+	if err := validate(checkGameID(gameID)...); err != nil {
+		return err
+	}
+	return s.next.SetCalls(ctx, gameID, c)
+}
+
+func (s validatingService) GetStats(ctx context.Context, gameID game.ID) (Stats, error) {
+	if err := validate(checkGameID(gameID)...); err != nil {
+		return Stats{}, err
+	}
+	return s.next.GetStats(ctx, gameID)
+}
+
+func (s validatingService) ClaimPrize(ctx context.Context, gameID game.ID, ticketID ticket.ID, prize game.Prize) error {
+	if err := validate(append(checkGameID(gameID), checkTicketID(ticketID)...)...); err != nil {
+		return err
+	}
+	return s.next.ClaimPrize(ctx, gameID, ticketID, prize)
+}
+
+func (s validatingService) Watch(ctx context.Context, gameID game.ID, lastEventID int64) (<-chan Event, error) {
+	if err := validate(checkGameID(gameID)...); err != nil {
+		return nil, err
+	}
+	return s.next.Watch(ctx, gameID, lastEventID)
+}
+
+func (s validatingService) StartGame(ctx context.Context, gameID game.ID) error {
+	if err := validate(checkGameID(gameID)...); err != nil {
+		return err
+	}
+	return s.next.StartGame(ctx, gameID)
+}
+
+func (s validatingService) PauseGame(ctx context.Context, gameID game.ID) error {
+	if err := validate(checkGameID(gameID)...); err != nil {
+		return err
+	}
+	return s.next.PauseGame(ctx, gameID)
+}
+
+func (s validatingService) ResumeGame(ctx context.Context, gameID game.ID) error {
+	if err := validate(checkGameID(gameID)...); err != nil {
+		return err
+	}
+	return s.next.ResumeGame(ctx, gameID)
+}
+
+func (s validatingService) EndGame(ctx context.Context, gameID game.ID) error {
+	if err := validate(checkGameID(gameID)...); err != nil {
+		return err
+	}
+	return s.next.EndGame(ctx, gameID)
+}
+
+func (s validatingService) GetSeed(ctx context.Context, gameID game.ID) (string, string, error) {
+	if err := validate(checkGameID(gameID)...); err != nil {
+		return "", "", err
+	}
+	return s.next.GetSeed(ctx, gameID)
+}
+
+func (s validatingService) StartAutoCall(ctx context.Context, gameID game.ID, interval time.Duration) error {
+	if err := validate(checkGameID(gameID)...); err != nil {
+		return err
+	}
+	return s.next.StartAutoCall(ctx, gameID, interval)
+}
+
+func (s validatingService) StopAutoCall(ctx context.Context, gameID game.ID) error {
+	if err := validate(checkGameID(gameID)...); err != nil {
+		return err
+	}
+	return s.next.StopAutoCall(ctx, gameID)
+}
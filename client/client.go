@@ -0,0 +1,131 @@
+// Package client provides a bingo.Service which calls the HTTP API of a remote bingo service, so callers can swap
+// a local service for a remote one.
+package client
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/divyanshgaba/bingo/bingo"
+	"github.com/divyanshgaba/bingo/game"
+
+	kithttp "github.com/go-kit/kit/transport/http"
+)
+
+// Option configures a client.
+type Option func(*options)
+
+type options struct {
+	token      string
+	httpClient *http.Client
+}
+
+// WithToken makes requests as the player logged in with session token, for creating games, buying tickets and
+// viewing, marking or claiming tickets.
+func WithToken(token string) Option {
+	return func(o *options) { o.token = token }
+}
+
+// WithHTTPClient sends requests with c instead of http.DefaultClient.
+func WithHTTPClient(c *http.Client) Option {
+	return func(o *options) { o.httpClient = c }
+}
+
+// client remembers host secrets of games it created or was authorized to host, and sends them along with host
+// operations on those games, so it can host games like a local service does.
+type client struct {
+	bingo.Service
+
+	mtx     sync.Mutex
+	secrets map[game.ID]string
+}
+
+// New returns a bingo.Service backed by the bingo service at instance, such as "http://localhost:8080".
+// Host operations need the host secret of the game, which the client knows for games it created; call
+// AuthorizeHost with the secret to host other games.
+func New(instance string, opts ...Option) (bingo.Service, error) {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+	var clientOpts []kithttp.ClientOption
+	if o.token != "" {
+		clientOpts = append(clientOpts, kithttp.ClientBefore(kithttp.SetRequestHeader("Authorization", "Bearer "+o.token)))
+	}
+	if o.httpClient != nil {
+		clientOpts = append(clientOpts, kithttp.SetClient(o.httpClient))
+	}
+	remote, err := bingo.NewHTTPClient(instance, clientOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return &client{Service: remote, secrets: make(map[game.ID]string)}, nil
+}
+
+func (c *client) CreateGame(ctx context.Context, f game.Format, r game.Rules) (game.ID, string, error) {
+	id, secret, err := c.Service.CreateGame(ctx, f, r)
+	if err != nil {
+		return "", "", err
+	}
+	c.remember(id, secret)
+	return id, secret, nil
+}
+
+func (c *client) AuthorizeHost(ctx context.Context, gameID game.ID, secret string) error {
+	if err := c.Service.AuthorizeHost(ctx, gameID, secret); err != nil {
+		return err
+	}
+	c.remember(gameID, secret)
+	return nil
+}
+
+func (c *client) GenerateNumber(ctx context.Context, gameID game.ID, locale string) (bingo.Draw, error) {
+	return c.Service.GenerateNumber(c.host(ctx, gameID), gameID, locale)
+}
+
+func (c *client) SetCalls(ctx context.Context, gameID game.ID, calls game.Calls) error {
+	return c.Service.SetCalls(c.host(ctx, gameID), gameID, calls)
+}
+
+func (c *client) StartGame(ctx context.Context, gameID game.ID) error {
+	return c.Service.StartGame(c.host(ctx, gameID), gameID)
+}
+
+func (c *client) PauseGame(ctx context.Context, gameID game.ID) error {
+	return c.Service.PauseGame(c.host(ctx, gameID), gameID)
+}
+
+func (c *client) ResumeGame(ctx context.Context, gameID game.ID) error {
+	return c.Service.ResumeGame(c.host(ctx, gameID), gameID)
+}
+
+func (c *client) EndGame(ctx context.Context, gameID game.ID) error {
+	return c.Service.EndGame(c.host(ctx, gameID), gameID)
+}
+
+func (c *client) StartAutoCall(ctx context.Context, gameID game.ID, interval time.Duration) error {
+	return c.Service.StartAutoCall(c.host(ctx, gameID), gameID, interval)
+}
+
+func (c *client) StopAutoCall(ctx context.Context, gameID game.ID) error {
+	return c.Service.StopAutoCall(c.host(ctx, gameID), gameID)
+}
+
+func (c *client) remember(gameID game.ID, secret string) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	c.secrets[gameID] = secret
+}
+
+// host returns ctx carrying the host secret of a game, if the client knows it.
+func (c *client) host(ctx context.Context, gameID game.ID) context.Context {
+	c.mtx.Lock()
+	secret, ok := c.secrets[gameID]
+	c.mtx.Unlock()
+	if !ok {
+		return ctx
+	}
+	return bingo.NewHostSecretContext(ctx, secret)
+}
@@ -0,0 +1,189 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/divyanshgaba/bingo/bingo"
+	"github.com/divyanshgaba/bingo/game"
+)
+
+var errNoGame = errors.New("bingoctl: -game is required")
+
+func gameCreate(fs *flag.FlagSet, c *cli) func() error {
+	var f game.Format
+	var r game.Rules
+	var cutoff string
+	fs.StringVar(&f.Name, "format", "", "format of tickets: classic|90-ball|75-ball|custom, classic when empty")
+	fs.IntVar(&f.Rows, "rows", 0, "rows of tickets, for -format=custom")
+	fs.IntVar(&f.Cols, "cols", 0, "columns of tickets, for -format=custom")
+	fs.IntVar(&f.Numbers, "numbers", 0, "numbers on each ticket, for -format=custom")
+	fs.Int64Var(&f.Min, "min", 0, "lowest number drawn, for -format=custom")
+	fs.Int64Var(&f.Max, "max", 0, "highest number drawn, for -format=custom")
+	fs.IntVar(&r.MaxTicketsPerUser, "max-tickets-per-user", 0, "tickets a player can buy, 0 for no limit")
+	fs.IntVar(&r.MaxTickets, "max-tickets", 0, "tickets sold in all, 0 for no limit")
+	fs.StringVar(&cutoff, "sales-cutoff", "", "when ticket sales close: start|first_draw")
+	return func() error {
+		r.SalesCutoff = game.SalesCutoff(cutoff)
+		ctx, cancel := c.context()
+		defer cancel()
+		id, secret, err := c.bs.CreateGame(ctx, f, r)
+		if err != nil {
+			return err
+		}
+		created := struct {
+			GameID     game.ID `json:"game_id"`
+			HostSecret string  `json:"host_secret"`
+		}{id, secret}
+		return c.print(created, fmt.Sprintf("game:        %v\nhost secret: %v\n", id, secret))
+	}
+}
+
+// hostFlags registers flags for the game a host operation is on, and its host secret.
+func hostFlags(fs *flag.FlagSet) (id, secret *string) {
+	id = fs.String("game", "", "ID of the game")
+	secret = fs.String("secret", os.Getenv("BINGO_HOST_SECRET"), "host secret of the game, returned by game create")
+	return id, secret
+}
+
+func gameStart(fs *flag.FlagSet, c *cli) func() error {
+	id, secret := hostFlags(fs)
+	return func() error {
+		if *id == "" {
+			return errNoGame
+		}
+		ctx, cancel := c.context()
+		defer cancel()
+		gameID := game.ID(*id)
+		if *secret != "" {
+			if err := c.bs.AuthorizeHost(ctx, gameID, *secret); err != nil {
+				return err
+			}
+		}
+		if err := c.bs.StartGame(ctx, gameID); err != nil {
+			return err
+		}
+		started := struct {
+			GameID game.ID     `json:"game_id"`
+			Status game.Status `json:"status"`
+		}{gameID, game.Running}
+		return c.print(started, fmt.Sprintf("started game %v\n", gameID))
+	}
+}
+
+func gameDraw(fs *flag.FlagSet, c *cli) func() error {
+	id, secret := hostFlags(fs)
+	locale := fs.String("locale", "", "call names to announce the number with: plain|uk|custom, those of the game when empty")
+	auto := fs.Bool("auto", false, "draw numbers automatically every -interval, until the game finishes")
+	interval := fs.Duration("interval", 5*time.Second, "interval between numbers drawn with -auto, from 100ms to 10m")
+	stop := fs.Bool("stop", false, "stop drawing numbers automatically")
+	return func() error {
+		if *id == "" {
+			return errNoGame
+		}
+		ctx, cancel := c.context()
+		defer cancel()
+		gameID := game.ID(*id)
+		if *secret != "" {
+			if err := c.bs.AuthorizeHost(ctx, gameID, *secret); err != nil {
+				return err
+			}
+		}
+		status := struct {
+			GameID   game.ID `json:"game_id"`
+			Enabled  bool    `json:"auto_call"`
+			Interval string  `json:"interval,omitempty"`
+		}{GameID: gameID}
+		switch {
+		case *stop:
+			if err := c.bs.StopAutoCall(ctx, gameID); err != nil {
+				return err
+			}
+			return c.print(status, fmt.Sprintf("stopped drawing numbers of game %v automatically\n", gameID))
+		case *auto:
+			if err := c.bs.StartAutoCall(ctx, gameID, *interval); err != nil {
+				return err
+			}
+			status.Enabled, status.Interval = true, interval.String()
+			return c.print(status, fmt.Sprintf("drawing numbers of game %v every %v\n", gameID, *interval))
+		}
+		d, err := c.bs.GenerateNumber(ctx, gameID, *locale)
+		if err != nil {
+			return err
+		}
+		return c.print(d, fmt.Sprintf("%d. %d - %s\n", d.Seq, d.Number, d.Announcement))
+	}
+}
+
+func gameNumbers(fs *flag.FlagSet, c *cli) func() error {
+	id := fs.String("game", "", "ID of the game")
+	since := fs.Int("since", 0, "skip the first numbers drawn")
+	locale := fs.String("locale", "", "call names to announce numbers with: plain|uk|custom, those of the game when empty")
+	return func() error {
+		if *id == "" {
+			return errNoGame
+		}
+		ctx, cancel := c.context()
+		defer cancel()
+		draws, err := c.bs.GetDraws(ctx, game.ID(*id), *since, *locale)
+		if err != nil {
+			return err
+		}
+		if draws == nil {
+			draws = []bingo.Draw{}
+		}
+		var sb strings.Builder
+		for _, d := range draws {
+			fmt.Fprintf(&sb, "%d. %d - %s\n", d.Seq, d.Number, d.Announcement)
+		}
+		return c.print(draws, sb.String())
+	}
+}
+
+func gameStats(fs *flag.FlagSet, c *cli) func() error {
+	id := fs.String("game", "", "ID of the game")
+	return func() error {
+		if *id == "" {
+			return errNoGame
+		}
+		ctx, cancel := c.context()
+		defer cancel()
+		stats, err := c.bs.GetStats(ctx, game.ID(*id))
+		if err != nil {
+			return err
+		}
+		return c.print(stats, statsText(stats))
+	}
+}
+
+// statsText renders stats of a game as aligned columns.
+func statsText(stats bingo.Stats) string {
+	var sb strings.Builder
+	w := tabwriter.NewWriter(&sb, 0, 4, 2, ' ', 0)
+	fmt.Fprintf(w, "numbers drawn:\t%d, %d remaining\n", stats.NumbersDrawn, stats.NumbersRemaining)
+	fmt.Fprintf(w, "tickets:\t%d, %d players\n", stats.TicketsGenerated, stats.Players)
+	if stats.DrawsPerMinute > 0 {
+		fmt.Fprintf(w, "draws per minute:\t%.1f\n", stats.DrawsPerMinute)
+	}
+	fmt.Fprintln(w, "prizes:")
+	for _, p := range stats.Prizes {
+		claimed := "open"
+		if p.Claimed {
+			claimed = "claimed by ticket " + string(p.TicketID)
+		}
+		fmt.Fprintf(w, "  %v\t%v\n", p.Prize, claimed)
+	}
+	if len(stats.Leaderboard) > 0 {
+		fmt.Fprintln(w, "leaderboard:\tfull house\tline\tticket")
+	}
+	for i, s := range stats.Leaderboard {
+		fmt.Fprintf(w, "  %d. %v\t%d away\t%d away\t%v\n", i+1, s.Username, s.FullHouseAway, s.LineAway, s.TicketID)
+	}
+	w.Flush()
+	return sb.String()
+}
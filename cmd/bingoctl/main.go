@@ -0,0 +1,129 @@
+// Command bingoctl hosts and plays games of bingo on a bingo service, through its HTTP API.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/divyanshgaba/bingo/bingo"
+	"github.com/divyanshgaba/bingo/client"
+)
+
+const usage = `usage: bingoctl <command> [flags]
+
+commands:
+  game create    create a game, hosted by the player of -token
+  game start     start a game, numbers can be drawn once it is started
+  game draw      draw a number, or with -auto draw numbers every -interval
+  game numbers   list numbers drawn in a game
+  game stats     show stats of a game
+  ticket create  buy a ticket for a player
+  ticket show    show a ticket, with numbers drawn highlighted
+
+Run bingoctl <command> -h for flags of a command. Flags -addr, -token and -secret
+default to environment variables BINGO_ADDR, BINGO_TOKEN and BINGO_HOST_SECRET.
+`
+
+var errUsage = errors.New("bingoctl: invalid usage")
+
+func main() {
+	err := run(os.Args[1:], os.Stdout)
+	switch {
+	case err == errUsage || err == flag.ErrHelp:
+		os.Exit(2)
+	case err != nil:
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+// command registers its flags on fs, and returns a func which runs the command once flags are parsed.
+type command func(fs *flag.FlagSet, c *cli) func() error
+
+var commands = map[string]map[string]command{
+	"game": {
+		"create":  gameCreate,
+		"start":   gameStart,
+		"draw":    gameDraw,
+		"numbers": gameNumbers,
+		"stats":   gameStats,
+	},
+	"ticket": {
+		"create": ticketCreate,
+		"show":   ticketShow,
+	},
+}
+
+func run(args []string, out io.Writer) error {
+	if len(args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		return errUsage
+	}
+	cmd, ok := commands[args[0]][args[1]]
+	if !ok {
+		fmt.Fprint(os.Stderr, usage)
+		return errUsage
+	}
+	fs := flag.NewFlagSet("bingoctl "+args[0]+" "+args[1], flag.ContinueOnError)
+	c := &cli{out: out}
+	fs.StringVar(&c.addr, "addr", envString("BINGO_ADDR", "http://localhost:8080"), "address of the bingo service")
+	fs.StringVar(&c.token, "token", os.Getenv("BINGO_TOKEN"), "session token of the player, from /api/player/login")
+	fs.BoolVar(&c.json, "json", false, "print output as JSON, for scripting")
+	fs.DurationVar(&c.timeout, "timeout", 10*time.Second, "timeout of calls to the service")
+	exec := cmd(fs, c)
+	if err := fs.Parse(args[2:]); err != nil {
+		if err == flag.ErrHelp {
+			return err
+		}
+		return errUsage // fs has reported the error
+	}
+	var opts []client.Option
+	if c.token != "" {
+		opts = append(opts, client.WithToken(c.token))
+	}
+	bs, err := client.New(c.addr, opts...)
+	if err != nil {
+		return err
+	}
+	c.bs = bs
+	return exec()
+}
+
+// cli holds what every command needs.
+type cli struct {
+	bs      bingo.Service
+	out     io.Writer
+	addr    string
+	token   string
+	json    bool
+	timeout time.Duration
+}
+
+func (c *cli) context() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.Background(), c.timeout)
+}
+
+// print writes v as indented JSON in JSON mode, or text otherwise.
+func (c *cli) print(v interface{}, text string) error {
+	if !c.json {
+		_, err := io.WriteString(c.out, text)
+		return err
+	}
+	enc := json.NewEncoder(c.out)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+func envString(env, fallback string) string {
+	e := os.Getenv(env)
+	if e == "" {
+		return fallback
+	}
+	return e
+}
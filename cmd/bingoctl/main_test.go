@@ -0,0 +1,152 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/divyanshgaba/bingo/account"
+	"github.com/divyanshgaba/bingo/bingo"
+	"github.com/divyanshgaba/bingo/game"
+	"github.com/divyanshgaba/bingo/inmem"
+	"github.com/divyanshgaba/bingo/ticket"
+	"github.com/go-kit/kit/log"
+)
+
+func TestRenderGrid(t *testing.T) {
+	tk := bingo.Ticket{
+		Rows:   2,
+		Cols:   3,
+		Cells:  []int64{5, ticket.EmptyCell, 12, ticket.FreeCell, 40, 7},
+		Drawn:  []int64{5, 12, 33},
+		Marked: []int64{12},
+	}
+	grid, err := renderGrid(tk, false)
+	if err != nil {
+		t.Fatalf("could not render ticket err=%v", err)
+	}
+	want := "" +
+		"+------+------+------+\n" +
+		"|   5* |      | [12] |\n" +
+		"+------+------+------+\n" +
+		"| FREE |   40 |    7 |\n" +
+		"+------+------+------+\n"
+	if grid != want {
+		t.Errorf("invalid grid want=\n%v got=\n%v", want, grid)
+	}
+
+	grid, _ = renderGrid(tk, true)
+	if !strings.Contains(grid, ansiDrawn+"5"+ansiReset) || !strings.Contains(grid, ansiMarked+"12"+ansiReset) ||
+		strings.Contains(grid, "5*") || strings.Contains(grid, "[12]") {
+		t.Errorf("invalid colored grid: %q", grid)
+	}
+
+	// a response with fewer cells than rows and columns
+	tk.Cells = tk.Cells[:4]
+	if _, err := renderGrid(tk, false); err == nil {
+		t.Errorf("malformed ticket rendered")
+	}
+}
+
+func TestStatsText(t *testing.T) {
+	text := statsText(bingo.Stats{
+		NumbersDrawn:     12,
+		NumbersRemaining: 78,
+		TicketsGenerated: 3,
+		Players:          2,
+		Prizes: []bingo.PrizeStatus{
+			{Prize: game.EarlyFive, Claimed: true, TicketID: "t1"},
+			{Prize: game.FullHouse},
+		},
+		Leaderboard: []bingo.Standing{{TicketID: "t1", Username: "alice", FullHouseAway: 4, LineAway: 1}},
+	})
+	for _, want := range []string{
+		"numbers drawn:  12, 78 remaining\n",
+		"tickets:        3, 2 players\n",
+		"early_five",
+		"claimed by ticket t1\n",
+		"open\n",
+		"1. alice",
+		"4 away",
+	} {
+		if !strings.Contains(text, want) {
+			t.Errorf("stats text misses %q:\n%v", want, text)
+		}
+	}
+	if strings.Contains(text, "draws per minute") {
+		t.Errorf("draws per minute shown before numbers were timed:\n%v", text)
+	}
+}
+
+func TestJSONMode(t *testing.T) {
+	games, tickets := inmem.NewGameRepository(), inmem.NewTicketRepository()
+	bs := bingo.NewService(games, tickets, inmem.NewTransactor(games, tickets), bingo.NewSeededSource(1))
+	accounts := account.NewService(inmem.NewPlayerRepository())
+	mux := http.NewServeMux()
+	mux.Handle("/api/player/", account.MakeHandler(accounts, log.NewNopLogger()))
+	mux.Handle("/", bingo.MakeHandler(bs, accounts, log.NewNopLogger()))
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+	if err := accounts.Register(context.Background(), "alice", "correct horse"); err != nil {
+		t.Fatalf("could not register err=%v", err)
+	}
+	token, _, err := accounts.Login(context.Background(), "alice", "correct horse")
+	if err != nil {
+		t.Fatalf("could not login err=%v", err)
+	}
+
+	// bingoctl runs a command with args as alice, decoding its JSON output into v
+	bingoctl := func(v interface{}, args ...string) {
+		var out bytes.Buffer
+		args = append(append(args[:2:2], "-addr", srv.URL, "-token", token, "-json"), args[2:]...)
+		if err := run(args, &out); err != nil {
+			t.Fatalf("could not run %v err=%v", args[:2], err)
+		}
+		if err := json.Unmarshal(out.Bytes(), v); err != nil {
+			t.Fatalf("invalid JSON output of %v: %v", args[:2], out.String())
+		}
+	}
+	var created struct {
+		GameID     string `json:"game_id"`
+		HostSecret string `json:"host_secret"`
+	}
+	bingoctl(&created, "game", "create", "-format", "90-ball")
+	if created.GameID == "" || created.HostSecret == "" {
+		t.Fatalf("invalid created game: %+v", created)
+	}
+	var bought struct {
+		TicketID string `json:"ticket_id"`
+	}
+	bingoctl(&bought, "ticket", "create", "-game", created.GameID, "-user", "alice")
+	var started struct {
+		Status game.Status `json:"status"`
+	}
+	bingoctl(&started, "game", "start", "-game", created.GameID, "-secret", created.HostSecret)
+	if started.Status != game.Running {
+		t.Errorf("invalid status of started game: %v", started.Status)
+	}
+	var drawn bingo.Draw
+	bingoctl(&drawn, "game", "draw", "-game", created.GameID, "-secret", created.HostSecret)
+	if drawn.Seq != 1 || drawn.Number < 1 || drawn.Number > 90 {
+		t.Errorf("invalid draw: %+v", drawn)
+	}
+	var draws []bingo.Draw
+	bingoctl(&draws, "game", "numbers", "-game", created.GameID)
+	if len(draws) != 1 || draws[0].Number != drawn.Number {
+		t.Errorf("invalid numbers drawn: %+v", draws)
+	}
+	var stats bingo.Stats
+	bingoctl(&stats, "game", "stats", "-game", created.GameID)
+	if stats.NumbersDrawn != 1 || stats.TicketsGenerated != 1 {
+		t.Errorf("invalid stats: %+v", stats)
+	}
+	var tk bingo.Ticket
+	bingoctl(&tk, "ticket", "show", bought.TicketID)
+	if string(tk.ID) != bought.TicketID || tk.Username != "alice" || len(tk.Cells) != tk.Rows*tk.Cols {
+		t.Errorf("invalid ticket: %+v", tk)
+	}
+}
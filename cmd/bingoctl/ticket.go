@@ -0,0 +1,138 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/divyanshgaba/bingo/bingo"
+	"github.com/divyanshgaba/bingo/game"
+	"github.com/divyanshgaba/bingo/ticket"
+)
+
+func ticketCreate(fs *flag.FlagSet, c *cli) func() error {
+	id := fs.String("game", "", "ID of the game")
+	user := fs.String("user", "", "username of the player buying the ticket, the player of -token")
+	return func() error {
+		if *id == "" {
+			return errNoGame
+		}
+		if *user == "" {
+			return errors.New("bingoctl: -user is required")
+		}
+		ctx, cancel := c.context()
+		defer cancel()
+		ticketID, err := c.bs.CreateTicket(ctx, game.ID(*id), *user)
+		if err != nil {
+			return err
+		}
+		created := struct {
+			TicketID ticket.ID `json:"ticket_id"`
+		}{ticketID}
+		return c.print(created, fmt.Sprintf("ticket: %v\n", ticketID))
+	}
+}
+
+func ticketShow(fs *flag.FlagSet, c *cli) func() error {
+	id := fs.String("ticket", "", "ID of the ticket, or pass it as argument")
+	color := fs.String("color", "auto", "highlight numbers with colors: auto|always|never")
+	return func() error {
+		if *id == "" {
+			*id = fs.Arg(0)
+		}
+		if *id == "" {
+			return errors.New("bingoctl: -ticket is required")
+		}
+		ctx, cancel := c.context()
+		defer cancel()
+		t, err := c.bs.ShowTicket(ctx, ticket.ID(*id))
+		if err != nil {
+			return err
+		}
+		colored := *color == "always" || *color == "auto" && isTerminal(c.out)
+		grid, err := renderGrid(t, colored)
+		if err != nil {
+			return err
+		}
+		return c.print(t, grid)
+	}
+}
+
+// ANSI escapes highlighting cells, as the HTML view of a ticket does
+const (
+	ansiMarked = "\x1b[30;43m" // black on yellow
+	ansiDrawn  = "\x1b[1;31m"  // bold red
+	ansiReset  = "\x1b[0m"
+)
+
+// renderGrid draws a ticket as a table. Numbers drawn are highlighted, marked ones on yellow and the others in bold
+// red. Without colors, numbers drawn are followed by `*` and marked ones are in brackets. It fails if the ticket
+// does not have a cell for every row and column.
+func renderGrid(t bingo.Ticket, colored bool) (string, error) {
+	if t.Rows < 0 || t.Cols < 0 || len(t.Cells) != t.Rows*t.Cols {
+		return "", fmt.Errorf("bingoctl: malformed ticket %v, %d cells for %d rows and %d columns", t.ID, len(t.Cells), t.Rows, t.Cols)
+	}
+	if t.Cols == 0 {
+		return "", nil
+	}
+	drawn, marked := numberSet(t.Drawn), numberSet(t.Marked)
+	width := 4 // fits FREE and numbers up to 999 in brackets
+	for _, n := range t.Cells {
+		if l := len(strconv.FormatInt(n, 10)) + 2; l > width {
+			width = l
+		}
+	}
+	line := "+" + strings.Repeat(strings.Repeat("-", width+2)+"+", t.Cols) + "\n"
+	var sb strings.Builder
+	sb.WriteString(line)
+	for r := 0; r < t.Rows; r++ {
+		sb.WriteString("|")
+		for _, n := range t.Cells[r*t.Cols : (r+1)*t.Cols] {
+			cell, style := "", ""
+			switch {
+			case n == ticket.EmptyCell:
+			case n == ticket.FreeCell:
+				cell = "FREE"
+			case colored && marked[n]:
+				cell, style = strconv.FormatInt(n, 10), ansiMarked
+			case colored && drawn[n]:
+				cell, style = strconv.FormatInt(n, 10), ansiDrawn
+			case marked[n]:
+				cell = "[" + strconv.FormatInt(n, 10) + "]"
+			case drawn[n]:
+				cell = strconv.FormatInt(n, 10) + "*"
+			default:
+				cell = strconv.FormatInt(n, 10)
+			}
+			pad := strings.Repeat(" ", width-len(cell))
+			if style != "" {
+				cell = style + cell + ansiReset
+			}
+			sb.WriteString(" " + pad + cell + " |")
+		}
+		sb.WriteString("\n" + line)
+	}
+	return sb.String(), nil
+}
+
+func numberSet(numbers []int64) map[int64]bool {
+	set := make(map[int64]bool, len(numbers))
+	for _, n := range numbers {
+		set[n] = true
+	}
+	return set
+}
+
+// isTerminal reports whether w is a terminal, rather than a pipe, file or buffer.
+func isTerminal(w io.Writer) bool {
+	f, ok := w.(*os.File)
+	if !ok {
+		return false
+	}
+	info, err := f.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
@@ -2,6 +2,7 @@ package config
 
 import (
 	"flag"
+	"sync"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/viper"
@@ -10,10 +11,15 @@ import (
 var (
 	env   = flag.String("env", "prod", "deployment environment for config. Default `prod`")
 	mongo *MongoConfiguration
+	once  sync.Once
 )
 
-func init() {
-	flag.Parse()
+// load reads config for the deployment environment. It is deferred until first use,
+// so that packages importing config do not require a config file unless they need one.
+func load() {
+	if !flag.Parsed() {
+		flag.Parse()
+	}
 	viper.AddConfigPath("$GOPATH/src/github.com/divyanshgaba/bingo")
 	viper.AddConfigPath(".")
 	viper.SetConfigType("yaml")
@@ -29,6 +35,7 @@ func init() {
 
 // Mongo returns instance of MongoConfiguration
 func Mongo() MongoConfiguration {
+	once.Do(load)
 	return *mongo
 }
 
@@ -0,0 +1,136 @@
+// Package errs holds the error type of business-logic, which carries what clients are told about an error, and
+// its encoding as RFC 7807 problem details.
+package errs
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sync"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/transport"
+)
+
+// Error is an error from business-logic. Code identifies the error for clients and never changes, Status is the
+// HTTP status it is served with and Message is safe to show to clients. Fields lists which arguments are invalid.
+type Error struct {
+	Code    string
+	Status  int
+	Message string
+	Fields  []Field
+}
+
+func (e *Error) Error() string {
+	if len(e.Fields) == 0 {
+		return e.Message
+	}
+	msg := e.Message + ":"
+	for i, f := range e.Fields {
+		if i > 0 {
+			msg += ";"
+		}
+		msg += " " + f.Name + " " + f.Reason
+	}
+	return msg
+}
+
+// Is reports whether target is an error with the same code, so errors with fields still match the error they were
+// made from.
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	return ok && t.Code == e.Code
+}
+
+// WithFields returns a copy of e which tells clients why each of fields is invalid.
+func (e *Error) WithFields(fields ...Field) *Error {
+	c := *e
+	c.Fields = append(append([]Field(nil), e.Fields...), fields...)
+	return &c
+}
+
+// Field is an invalid argument and the reason it is invalid, safe to show to clients.
+type Field struct {
+	Name   string `json:"name"`
+	Reason string `json:"reason"`
+}
+
+var (
+	mtx   sync.Mutex
+	codes = make(map[string]*Error)
+)
+
+// New returns an error from business-logic, code must be unique.
+func New(code string, status int, message string) *Error {
+	mtx.Lock()
+	defer mtx.Unlock()
+	if _, ok := codes[code]; ok {
+		panic("errs: duplicate code " + code)
+	}
+	e := &Error{Code: code, Status: status, Message: message}
+	codes[code] = e
+	return e
+}
+
+// Lookup returns the error created with code, so clients can get back the error a problem was encoded from.
+func Lookup(code string) (*Error, bool) {
+	mtx.Lock()
+	defer mtx.Unlock()
+	e, ok := codes[code]
+	return e, ok
+}
+
+// Internal is what clients are told about errors which are not from business-logic, such as errors of a store.
+var Internal = New("internal", http.StatusInternalServerError, "internal error")
+
+// From returns the error from business-logic err is or wraps, or Internal for any other error.
+func From(err error) *Error {
+	var e *Error
+	if errors.As(err, &e) {
+		return e
+	}
+	return Internal
+}
+
+// Problem is the RFC 7807 problem details body errors are served with.
+type Problem struct {
+	Type   string `json:"type"`
+	Title  string `json:"title"`
+	Status int    `json:"status"`
+	Detail string `json:"detail,omitempty"`
+	Code   string `json:"code"`
+	// InvalidParams is the extension member RFC 7807 suggests for errors of validation.
+	InvalidParams []Field `json:"invalid-params,omitempty"`
+}
+
+// ProblemContentType is the content type of problem details.
+const ProblemContentType = "application/problem+json"
+
+// EncodeError writes err as problem details. Errors not from business-logic are served as Internal, so details
+// of a store never reach clients; log them with NewLogErrorHandler.
+func EncodeError(_ context.Context, err error, w http.ResponseWriter) {
+	e := From(err)
+	w.Header().Set("Content-Type", ProblemContentType+"; charset=utf-8")
+	w.WriteHeader(e.Status)
+	json.NewEncoder(w).Encode(Problem{
+		Type:          "about:blank",
+		Title:         http.StatusText(e.Status),
+		Status:        e.Status,
+		Detail:        e.Message,
+		Code:          e.Code,
+		InvalidParams: e.Fields,
+	})
+}
+
+// NewLogErrorHandler returns a transport.ErrorHandler which logs errors not from business-logic. Errors from
+// business-logic are expected, clients are told about them and they are not logged.
+func NewLogErrorHandler(logger log.Logger) transport.ErrorHandler {
+	return transport.ErrorHandlerFunc(func(_ context.Context, err error) {
+		var e *Error
+		if errors.As(err, &e) {
+			return
+		}
+		logger.Log("err", err)
+	})
+}
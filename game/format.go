@@ -0,0 +1,38 @@
+package game
+
+// names of formats a game can be played in
+const (
+	FormatClassic = "classic" // 3x9 tickets with 15 numbers in [0,100), used before formats were introduced
+	Format90Ball  = "90-ball" // UK style 3x9 tickets, column i holds numbers from its tens
+	Format75Ball  = "75-ball" // US style 5x5 cards with a free centre
+	FormatCustom  = "custom"  // grid and range of numbers chosen by the host
+)
+
+// Format describes tickets of a game and the pool of numbers drawn for it.
+// The zero Format is the classic format.
+type Format struct {
+	Name       string
+	Rows, Cols int
+	Min, Max   int64 // range of numbers which can be drawn, both inclusive
+	Numbers    int   // numbers on each ticket
+}
+
+// preset formats
+var (
+	Classic         = Format{Name: FormatClassic, Rows: 3, Cols: 9, Min: 0, Max: 99, Numbers: 15}
+	NinetyBall      = Format{Name: Format90Ball, Rows: 3, Cols: 9, Min: 1, Max: 90, Numbers: 15}
+	SeventyFiveBall = Format{Name: Format75Ball, Rows: 5, Cols: 5, Min: 1, Max: 75, Numbers: 24}
+)
+
+// OrClassic returns the classic format for a zero Format, f otherwise.
+func (f Format) OrClassic() Format {
+	if f.Name == "" {
+		return Classic
+	}
+	return f
+}
+
+// Balls returns count of numbers which can be drawn in the format.
+func (f Format) Balls() int {
+	return int(f.Max - f.Min + 1)
+}
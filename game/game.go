@@ -2,24 +2,142 @@ package game
 
 import (
 	"context"
-	"errors"
+	"encoding/hex"
+	"net/http"
+	"time"
 
+	"github.com/divyanshgaba/bingo/errs"
 	"github.com/divyanshgaba/bingo/ticket"
 )
 
 // errors for package game
 var (
-	ErrInvalidID = errors.New("game: invalid ID")
+	ErrInvalidID         = errs.New("game.invalid_id", http.StatusBadRequest, "game: invalid ID")
+	ErrPrizeClaimed      = errs.New("game.prize_claimed", http.StatusConflict, "game: prize already claimed")
+	ErrInvalidTransition = errs.New("game.invalid_transition", http.StatusConflict, "game: invalid status transition")
+	ErrConcurrentUpdate  = errs.New("game.concurrent_update", http.StatusConflict, "game: game was updated concurrently")
 )
 
 // ID uniquely identifies a particular game.
 type ID string
 
+// Valid reports whether id is well-formed, 24 hexadecimal characters as IDs are generated by stores. IDs which
+// are not well-formed can be rejected without looking them up.
+func (id ID) Valid() bool {
+	if len(id) != 24 {
+		return false
+	}
+	_, err := hex.DecodeString(string(id))
+	return err == nil
+}
+
+// Status is the stage of a game in its lifecycle.
+type Status string
+
+// statuses of a game
+const (
+	Lobby    Status = "lobby"    // tickets are being sold, no numbers drawn yet
+	Running  Status = "running"  // numbers are being drawn
+	Paused   Status = "paused"   // drawing is on hold
+	Finished Status = "finished" // no more numbers can be drawn
+)
+
+// transitions lists statuses a game can move to from each status.
+var transitions = map[Status][]Status{
+	Lobby:   {Running, Finished},
+	Running: {Paused, Finished},
+	Paused:  {Running, Finished},
+}
+
+// CanTransition reports whether a game can move from status s to status to.
+func (s Status) CanTransition(to Status) bool {
+	for _, t := range transitions[s] {
+		if t == to {
+			return true
+		}
+	}
+	return false
+}
+
+// Prize is a pattern on a ticket which can be claimed once per game.
+type Prize string
+
+// prizes which can be claimed in a game
+const (
+	EarlyFive  Prize = "early_five"
+	TopLine    Prize = "top_line"
+	MiddleLine Prize = "middle_line"
+	BottomLine Prize = "bottom_line"
+	FullHouse  Prize = "full_house"
+)
+
+// Prizes lists all prizes in the order they are usually won.
+var Prizes = []Prize{EarlyFive, TopLine, MiddleLine, BottomLine, FullHouse}
+
+// Winner records the ticket which claimed a prize.
+type Winner struct {
+	Prize  Prize
+	Ticket ticket.ID
+}
+
+// callers of numbers which are not players
+const (
+	CallerHost = "host" // drawn with the host secret of a game created before games had a host
+	CallerAuto = "auto" // drawn by the auto-caller
+)
+
+// Draw records a number drawn in a game. Seq counts numbers drawn in the game, from 1. Caller is the username of
+// the host for numbers drawn by the host, or CallerAuto. Numbers drawn before draws were recorded have neither
+// time nor caller.
+type Draw struct {
+	Seq     int
+	Number  int64
+	DrawnAt time.Time
+	Caller  string
+}
+
+// Calls sets how numbers drawn in a game are announced, Locale names a catalogue of call names and Custom holds
+// call names uploaded by the host, by number.
+type Calls struct {
+	Locale string
+	Custom map[int64]string
+}
+
+// SalesCutoff is the point from which no more tickets are sold for a game.
+type SalesCutoff string
+
+// cutoffs for ticket sales
+const (
+	CutoffStart     SalesCutoff = "start"      // sales close when the game starts
+	CutoffFirstDraw SalesCutoff = "first_draw" // sales close when the first number is drawn
+)
+
+// Rules limit tickets sold for a game, zero limits mean no limit.
+type Rules struct {
+	MaxTicketsPerUser int
+	MaxTickets        int
+	SalesCutoff       SalesCutoff // zero means CutoffStart
+}
+
 // Game is the central class in the domain model.
 type Game struct {
-	ID      ID
-	Tickets []ticket.ID
-	Numbers []int64
+	ID     ID
+	Status Status
+	Format Format
+	Rules  Rules
+	Host   string // username of the player who created the game, empty for games created before players had accounts
+	// HostSecretHash is the hash of the secret which host operations on the game need, empty for games created before.
+	HostSecretHash string
+	Tickets        []ticket.ID
+	Numbers        []int64 // numbers of Draws, in the order they were drawn
+	Draws          []Draw
+	Winners        []Winner
+	// Seed from which draws and tickets are derived, kept secret until the game finishes. SeedHash
+	// is published as commitment to it. Both are empty for games not played with a seed.
+	Seed, SeedHash string
+	// AutoCall is the interval at which numbers are drawn automatically, zero when the host draws them.
+	AutoCall time.Duration
+	Calls    Calls
 }
 
 // New creates a new game.
@@ -28,13 +146,66 @@ func New(id ID, tickets []ticket.ID, numbers []int64) *Game {
 		ID:      id,
 		Tickets: tickets,
 		Numbers: numbers,
+		Draws:   DrawsOf(numbers),
 	}
 }
 
+// DrawsOf returns draws of numbers drawn before draws were recorded, in order.
+func DrawsOf(numbers []int64) []Draw {
+	draws := make([]Draw, len(numbers))
+	for i, n := range numbers {
+		draws[i] = Draw{Seq: i + 1, Number: n}
+	}
+	return draws
+}
+
+// SalesOpen reports whether tickets can be sold for the game.
+func (g Game) SalesOpen() bool {
+	switch {
+	case g.Status == Lobby:
+		return true
+	case g.Status == Running && g.Rules.SalesCutoff == CutoffFirstDraw:
+		return len(g.Numbers) == 0
+	default:
+		return false
+	}
+}
+
+// Winner returns the winner of prize p, if it has been claimed.
+func (g Game) Winner(p Prize) (Winner, bool) {
+	for _, w := range g.Winners {
+		if w.Prize == p {
+			return w, true
+		}
+	}
+	return Winner{}, false
+}
+
 // Repository provides access a game store.
 type Repository interface {
 	Insert(context.Context, Game) (ID, error)
 	Find(context.Context, ID) (Game, error)
-	AddTicket(context.Context, ID, ticket.ID) error
-	AddNumber(context.Context, ID, int64) error
+	AddTicket(context.Context, ID, ...ticket.ID) error
+	// AddNumber appends draw d to draws of a running game, only if exactly d.Seq-1 numbers were drawn before
+	// and d.Number is not one of them. Returns ErrConcurrentUpdate otherwise.
+	AddNumber(ctx context.Context, id ID, d Draw) error
+	// AddWinner records w on the game, returns ErrPrizeClaimed if the prize already has a winner.
+	AddWinner(context.Context, ID, Winner) error
+	// SetStatus moves the game from status `from` to `to`, returns ErrInvalidTransition if the game is not in status `from`.
+	SetStatus(ctx context.Context, id ID, from, to Status) error
+	// SetAutoCall sets the interval at which numbers of the game are drawn automatically, zero turns it off.
+	SetAutoCall(ctx context.Context, id ID, interval time.Duration) error
+	// SetCalls sets how numbers drawn in the game are announced.
+	SetCalls(context.Context, ID, Calls) error
+	// FindAutoCalled returns all games whose numbers are drawn automatically.
+	FindAutoCalled(context.Context) ([]Game, error)
+	// CountActive returns the count of games which have not finished.
+	CountActive(context.Context) (int, error)
+}
+
+// Transactor runs units of work which change games and tickets together.
+type Transactor interface {
+	// WithinTransaction runs fn with repositories whose changes are kept only if fn returns nil,
+	// and are discarded if it returns an error.
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context, games Repository, tickets ticket.Repository) error) error
 }
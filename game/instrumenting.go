@@ -0,0 +1,93 @@
+package game
+
+import (
+	"context"
+	"time"
+
+	"github.com/divyanshgaba/bingo/ticket"
+	"github.com/go-kit/kit/metrics"
+)
+
+// observe records in latency the seconds since begin of a call to method of repository.
+func observe(latency metrics.Histogram, repository, method string, begin time.Time) {
+	latency.With("repository", repository, "method", method).Observe(time.Since(begin).Seconds())
+}
+
+type instrumentingRepository struct {
+	latency metrics.Histogram
+	next    Repository
+}
+
+// NewInstrumentingRepository returns a Repository which records latency of calls to r in seconds,
+// labelled by repository and method.
+func NewInstrumentingRepository(latency metrics.Histogram, r Repository) Repository {
+	return instrumentingRepository{latency: latency, next: r}
+}
+
+func (r instrumentingRepository) Insert(ctx context.Context, g Game) (ID, error) {
+	defer observe(r.latency, "games", "insert", time.Now())
+	return r.next.Insert(ctx, g)
+}
+
+func (r instrumentingRepository) Find(ctx context.Context, id ID) (Game, error) {
+	defer observe(r.latency, "games", "find", time.Now())
+	return r.next.Find(ctx, id)
+}
+
+func (r instrumentingRepository) AddTicket(ctx context.Context, id ID, ticketIDs ...ticket.ID) error {
+	defer observe(r.latency, "games", "add_ticket", time.Now())
+	return r.next.AddTicket(ctx, id, ticketIDs...)
+}
+
+func (r instrumentingRepository) AddNumber(ctx context.Context, id ID, d Draw) error {
+	defer observe(r.latency, "games", "add_number", time.Now())
+	return r.next.AddNumber(ctx, id, d)
+}
+
+func (r instrumentingRepository) AddWinner(ctx context.Context, id ID, w Winner) error {
+	defer observe(r.latency, "games", "add_winner", time.Now())
+	return r.next.AddWinner(ctx, id, w)
+}
+
+func (r instrumentingRepository) SetStatus(ctx context.Context, id ID, from, to Status) error {
+	defer observe(r.latency, "games", "set_status", time.Now())
+	return r.next.SetStatus(ctx, id, from, to)
+}
+
+func (r instrumentingRepository) SetAutoCall(ctx context.Context, id ID, interval time.Duration) error {
+	defer observe(r.latency, "games", "set_auto_call", time.Now())
+	return r.next.SetAutoCall(ctx, id, interval)
+}
+
+func (r instrumentingRepository) SetCalls(ctx context.Context, id ID, c Calls) error {
+	defer observe(r.latency, "games", "set_calls", time.Now())
+	return r.next.SetCalls(ctx, id, c)
+}
+
+func (r instrumentingRepository) FindAutoCalled(ctx context.Context) ([]Game, error) {
+	defer observe(r.latency, "games", "find_auto_called", time.Now())
+	return r.next.FindAutoCalled(ctx)
+}
+
+func (r instrumentingRepository) CountActive(ctx context.Context) (int, error) {
+	defer observe(r.latency, "games", "count_active", time.Now())
+	return r.next.CountActive(ctx)
+}
+
+type instrumentingTransactor struct {
+	latency metrics.Histogram
+	next    Transactor
+}
+
+// NewInstrumentingTransactor returns a Transactor which records latency of whole units of work in seconds,
+// and of calls to the repositories they are run with.
+func NewInstrumentingTransactor(latency metrics.Histogram, tx Transactor) Transactor {
+	return instrumentingTransactor{latency: latency, next: tx}
+}
+
+func (t instrumentingTransactor) WithinTransaction(ctx context.Context, fn func(context.Context, Repository, ticket.Repository) error) error {
+	defer observe(t.latency, "transactions", "within_transaction", time.Now())
+	return t.next.WithinTransaction(ctx, func(ctx context.Context, games Repository, tickets ticket.Repository) error {
+		return fn(ctx, NewInstrumentingRepository(t.latency, games), ticket.NewInstrumentingRepository(t.latency, tickets))
+	})
+}
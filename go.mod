@@ -1,11 +1,18 @@
 module github.com/divyanshgaba/bingo
 
-go 1.12
+go 1.13
 
 require (
 	github.com/go-kit/kit v0.10.0
+	github.com/golang/protobuf v1.3.3
 	github.com/gorilla/mux v1.7.4
+	github.com/gorilla/websocket v1.4.2
+	github.com/prometheus/client_golang v1.3.0
 	github.com/sirupsen/logrus v1.6.0
 	github.com/spf13/viper v1.7.0
 	go.mongodb.org/mongo-driver v1.3.4
+	golang.org/x/crypto v0.0.0-20191011191535-87dc89f01550
+	golang.org/x/text v0.3.2
+	google.golang.org/genproto v0.0.0-20200331122359-1ee6d9798940
+	google.golang.org/grpc v1.27.0
 )
@@ -0,0 +1,68 @@
+package inmem
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/divyanshgaba/bingo/player"
+)
+
+type playerRepository struct {
+	mtx      sync.RWMutex
+	players  map[string]player.Player
+	sessions map[string]player.Session
+}
+
+// NewPlayerRepository returns implementation for player.Repository with in-memory store.
+func NewPlayerRepository() player.Repository {
+	return &playerRepository{
+		players:  make(map[string]player.Player),
+		sessions: make(map[string]player.Session),
+	}
+}
+
+func (r *playerRepository) Insert(_ context.Context, p player.Player) error {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	if _, ok := r.players[p.Username]; ok {
+		return player.ErrUsernameTaken
+	}
+	p.PasswordHash = append([]byte(nil), p.PasswordHash...)
+	r.players[p.Username] = p
+	return nil
+}
+
+func (r *playerRepository) Find(_ context.Context, username string) (player.Player, error) {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+	p, ok := r.players[username]
+	if !ok {
+		return player.Player{}, player.ErrNotFound
+	}
+	p.PasswordHash = append([]byte(nil), p.PasswordHash...)
+	return p, nil
+}
+
+func (r *playerRepository) InsertSession(_ context.Context, s player.Session) error {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	now := time.Now()
+	for hash, s := range r.sessions { // drop expired sessions
+		if now.After(s.ExpiresAt) {
+			delete(r.sessions, hash)
+		}
+	}
+	r.sessions[s.TokenHash] = s
+	return nil
+}
+
+func (r *playerRepository) FindSession(_ context.Context, tokenHash string) (player.Session, error) {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+	s, ok := r.sessions[tokenHash]
+	if !ok || time.Now().After(s.ExpiresAt) {
+		return player.Session{}, player.ErrInvalidToken
+	}
+	return s, nil
+}
@@ -0,0 +1,346 @@
+package inmem
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"github.com/divyanshgaba/bingo/game"
+	"github.com/divyanshgaba/bingo/ticket"
+)
+
+type gameRepository struct {
+	mtx   sync.RWMutex
+	games map[game.ID]*game.Game
+}
+
+// NewGameRepository returns implementation for game.Repository with in-memory store.
+func NewGameRepository() game.Repository {
+	return &gameRepository{
+		games: make(map[game.ID]*game.Game),
+	}
+}
+
+func (r *gameRepository) Insert(_ context.Context, g game.Game) (game.ID, error) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	g.ID = game.ID(newID())
+	g.Tickets = append([]ticket.ID(nil), g.Tickets...)
+	g.Numbers = append([]int64(nil), g.Numbers...)
+	g.Draws = append([]game.Draw(nil), g.Draws...)
+	g.Calls = copyCalls(g.Calls)
+	if len(g.Draws) != len(g.Numbers) {
+		g.Draws = game.DrawsOf(g.Numbers)
+	}
+	g.Winners = append([]game.Winner(nil), g.Winners...)
+	r.games[g.ID] = &g
+	return g.ID, nil
+}
+
+func (r *gameRepository) Find(_ context.Context, gameID game.ID) (game.Game, error) {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+	g, ok := r.games[gameID]
+	if !ok {
+		return game.Game{}, game.ErrInvalidID
+	}
+	return copyGame(g), nil
+}
+
+func (r *gameRepository) AddTicket(_ context.Context, gameID game.ID, ticketIDs ...ticket.ID) error {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	g, ok := r.games[gameID]
+	if !ok {
+		return game.ErrInvalidID
+	}
+	g.Tickets = append(g.Tickets, ticketIDs...)
+	return nil
+}
+
+func (r *gameRepository) AddNumber(_ context.Context, gameID game.ID, d game.Draw) error {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	g, ok := r.games[gameID]
+	if !ok {
+		return game.ErrInvalidID
+	}
+	if g.Status != game.Running || len(g.Numbers) != d.Seq-1 {
+		return game.ErrConcurrentUpdate
+	}
+	for _, n := range g.Numbers {
+		if n == d.Number {
+			return game.ErrConcurrentUpdate
+		}
+	}
+	g.Numbers = append(g.Numbers, d.Number)
+	g.Draws = append(g.Draws, d)
+	return nil
+}
+
+func (r *gameRepository) AddWinner(_ context.Context, gameID game.ID, w game.Winner) error {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	g, ok := r.games[gameID]
+	if !ok {
+		return game.ErrInvalidID
+	}
+	if _, claimed := g.Winner(w.Prize); claimed {
+		return game.ErrPrizeClaimed
+	}
+	g.Winners = append(g.Winners, w)
+	return nil
+}
+
+func (r *gameRepository) SetStatus(_ context.Context, gameID game.ID, from, to game.Status) error {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	g, ok := r.games[gameID]
+	if !ok {
+		return game.ErrInvalidID
+	}
+	if g.Status != from {
+		return game.ErrInvalidTransition
+	}
+	g.Status = to
+	return nil
+}
+
+func (r *gameRepository) SetAutoCall(_ context.Context, gameID game.ID, interval time.Duration) error {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	g, ok := r.games[gameID]
+	if !ok {
+		return game.ErrInvalidID
+	}
+	g.AutoCall = interval
+	return nil
+}
+
+func (r *gameRepository) SetCalls(_ context.Context, gameID game.ID, calls game.Calls) error {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	g, ok := r.games[gameID]
+	if !ok {
+		return game.ErrInvalidID
+	}
+	g.Calls = copyCalls(calls)
+	return nil
+}
+
+func (r *gameRepository) FindAutoCalled(_ context.Context) ([]game.Game, error) {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+	var games []game.Game
+	for _, g := range r.games {
+		if g.AutoCall > 0 {
+			games = append(games, copyGame(g))
+		}
+	}
+	return games, nil
+}
+
+func (r *gameRepository) CountActive(_ context.Context) (int, error) {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+	n := 0
+	for _, g := range r.games {
+		if g.Status != game.Finished {
+			n++
+		}
+	}
+	return n, nil
+}
+
+// snapshot returns a repository holding a copy of games in r, it must be called with mtx held.
+func (r *gameRepository) snapshot() *gameRepository {
+	s := &gameRepository{games: make(map[game.ID]*game.Game, len(r.games))}
+	for id, g := range r.games {
+		c := copyGame(g)
+		s.games[id] = &c
+	}
+	return s
+}
+
+// copyGame returns a copy of g which does not share slices with the store.
+func copyGame(g *game.Game) game.Game {
+	c := *g
+	c.Tickets = append([]ticket.ID{}, g.Tickets...)
+	c.Numbers = append([]int64{}, g.Numbers...)
+	c.Draws = append([]game.Draw{}, g.Draws...)
+	c.Calls = copyCalls(g.Calls)
+	c.Winners = append([]game.Winner{}, g.Winners...)
+	return c
+}
+
+func copyCalls(calls game.Calls) game.Calls {
+	c := game.Calls{Locale: calls.Locale}
+	if calls.Custom != nil {
+		c.Custom = make(map[int64]string, len(calls.Custom))
+		for n, name := range calls.Custom {
+			c.Custom[n] = name
+		}
+	}
+	return c
+}
+
+type ticketRepository struct {
+	mtx     sync.RWMutex
+	tickets map[ticket.ID]*ticket.Ticket
+	byGame  map[string][]ticket.ID // in order of creation
+}
+
+// NewTicketRepository returns implementation for ticket.Repository with in-memory store.
+func NewTicketRepository() ticket.Repository {
+	return &ticketRepository{
+		tickets: make(map[ticket.ID]*ticket.Ticket),
+		byGame:  make(map[string][]ticket.ID),
+	}
+}
+
+func (r *ticketRepository) Insert(_ context.Context, t ticket.Ticket) (ticket.ID, error) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	t.ID = ticket.ID(newID())
+	t.Marked = append([]int64(nil), t.Marked...)
+	t.CreatedAt = time.Now().UTC()
+	r.tickets[t.ID] = &t
+	r.byGame[t.GameID] = append(r.byGame[t.GameID], t.ID)
+	return t.ID, nil
+}
+
+func (r *ticketRepository) Find(_ context.Context, ticketID ticket.ID) (ticket.Ticket, error) {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+	t, ok := r.tickets[ticketID]
+	if !ok {
+		return ticket.Ticket{}, ticket.ErrInvalidID
+	}
+	return copyTicket(t), nil
+}
+
+func (r *ticketRepository) InsertStrip(_ context.Context, ts []ticket.Ticket) (ticket.StripID, []ticket.ID, error) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	stripID := ticket.StripID(newID())
+	ids := make([]ticket.ID, len(ts))
+	for i := range ts {
+		t := ts[i]
+		t.Marked = append([]int64(nil), t.Marked...)
+		t.ID = ticket.ID(newID())
+		t.StripID = stripID
+		t.CreatedAt = time.Now().UTC()
+		r.tickets[t.ID] = &t
+		r.byGame[t.GameID] = append(r.byGame[t.GameID], t.ID)
+		ids[i] = t.ID
+	}
+	return stripID, ids, nil
+}
+
+func (r *ticketRepository) Mark(_ context.Context, ticketID ticket.ID, number int64) error {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	t, ok := r.tickets[ticketID]
+	if !ok {
+		return ticket.ErrInvalidID
+	}
+	for _, n := range t.Marked {
+		if n == number {
+			return nil
+		}
+	}
+	t.Marked = append(t.Marked, number)
+	return nil
+}
+
+func (r *ticketRepository) Unmark(_ context.Context, ticketID ticket.ID, number int64) error {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	t, ok := r.tickets[ticketID]
+	if !ok {
+		return ticket.ErrInvalidID
+	}
+	for i, n := range t.Marked {
+		if n == number {
+			t.Marked = append(t.Marked[:i], t.Marked[i+1:]...)
+			return nil
+		}
+	}
+	return nil
+}
+
+func (r *ticketRepository) SetAutoDaub(_ context.Context, ticketID ticket.ID, enabled bool) error {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	t, ok := r.tickets[ticketID]
+	if !ok {
+		return ticket.ErrInvalidID
+	}
+	t.AutoDaub = enabled
+	return nil
+}
+
+func (r *ticketRepository) FindByGame(_ context.Context, gameID string, offset, limit int) ([]ticket.Ticket, int, error) {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+	ids := r.byGame[gameID]
+	total := len(ids)
+	if offset > total {
+		offset = total
+	}
+	ids = ids[offset:]
+	if limit < len(ids) {
+		ids = ids[:limit]
+	}
+	ts := make([]ticket.Ticket, len(ids))
+	for i, id := range ids {
+		ts[i] = copyTicket(r.tickets[id])
+	}
+	return ts, total, nil
+}
+
+// snapshot returns a repository holding a copy of tickets in r, it must be called with mtx held.
+func (r *ticketRepository) snapshot() *ticketRepository {
+	s := &ticketRepository{
+		tickets: make(map[ticket.ID]*ticket.Ticket, len(r.tickets)),
+		byGame:  make(map[string][]ticket.ID, len(r.byGame)),
+	}
+	for id, t := range r.tickets {
+		c := copyTicket(t)
+		s.tickets[id] = &c
+	}
+	for gameID, ids := range r.byGame {
+		s.byGame[gameID] = append([]ticket.ID(nil), ids...)
+	}
+	return s
+}
+
+func (r *ticketRepository) CountByUser(_ context.Context, gameID, username string) (int, error) {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+	count := 0
+	for _, id := range r.byGame[gameID] {
+		if r.tickets[id].Username == username {
+			count++
+		}
+	}
+	return count, nil
+}
+
+// copyTicket returns a copy of t which does not share slices with the store.
+func copyTicket(t *ticket.Ticket) ticket.Ticket {
+	c := *t
+	c.Marked = append([]int64{}, t.Marked...)
+	return c
+}
+
+// newID returns a random 24 character hex string, same format as IDs issued by the mongo store.
+func newID() string {
+	b := make([]byte, 12)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,44 @@
+package inmem
+
+import (
+	"context"
+
+	"github.com/divyanshgaba/bingo/game"
+	"github.com/divyanshgaba/bingo/ticket"
+)
+
+type transactor struct {
+	games   *gameRepository
+	tickets *ticketRepository
+}
+
+// NewTransactor returns implementation for game.Transactor with in-memory store. A unit of work holds both
+// stores locked, so others neither see its changes before it succeeds nor change the stores meanwhile. It runs
+// on snapshots of the stores, which replace them only if it succeeds.
+// games and tickets must be made by NewGameRepository and NewTicketRepository, so the stores themselves can be
+// locked and replaced. NewTransactor panics otherwise.
+func NewTransactor(games game.Repository, tickets ticket.Repository) game.Transactor {
+	gs, ok := games.(*gameRepository)
+	if !ok {
+		panic("inmem: transactor needs a game repository made by NewGameRepository")
+	}
+	ts, ok := tickets.(*ticketRepository)
+	if !ok {
+		panic("inmem: transactor needs a ticket repository made by NewTicketRepository")
+	}
+	return &transactor{games: gs, tickets: ts}
+}
+
+func (t *transactor) WithinTransaction(ctx context.Context, fn func(context.Context, game.Repository, ticket.Repository) error) error {
+	t.games.mtx.Lock()
+	defer t.games.mtx.Unlock()
+	t.tickets.mtx.Lock()
+	defer t.tickets.mtx.Unlock()
+	games, tickets := t.games.snapshot(), t.tickets.snapshot()
+	if err := fn(ctx, games, tickets); err != nil {
+		return err
+	}
+	t.games.games = games.games
+	t.tickets.tickets, t.tickets.byGame = tickets.tickets, tickets.byGame
+	return nil
+}
@@ -1,16 +1,30 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
+	"math"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
+	"github.com/divyanshgaba/bingo/account"
 	"github.com/divyanshgaba/bingo/bingo"
+	"github.com/divyanshgaba/bingo/game"
+	"github.com/divyanshgaba/bingo/inmem"
 	"github.com/divyanshgaba/bingo/mongo"
+	"github.com/divyanshgaba/bingo/pb"
+	"github.com/divyanshgaba/bingo/player"
+	"github.com/divyanshgaba/bingo/ticket"
 	"github.com/go-kit/kit/log"
+	kitprometheus "github.com/go-kit/kit/metrics/prometheus"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"google.golang.org/grpc"
 )
 
 const (
@@ -21,7 +35,12 @@ func main() {
 	var (
 		addr = envString("PORT", defaultPort)
 
-		httpAddr = flag.String("http.addr", ":"+addr, "HTTP listen address")
+		httpAddr  = flag.String("http.addr", ":"+addr, "HTTP listen address")
+		grpcAddr  = flag.String("grpc.addr", ":8082", "gRPC listen address, empty turns gRPC off")
+		storeType = flag.String("store", "mongo", "store backend to use: memory|mongo")
+		rngType   = flag.String("rng", "crypto", "source of randomness for draws and tickets: crypto|seeded|commit")
+		rngSeed   = flag.Int64("rng.seed", 1, "seed for -rng=seeded")
+		statsMin  = flag.Int("stats.cache", 0, "cache stats of games with at least this many tickets, 0 turns caching off")
 	)
 	flag.Parse()
 
@@ -29,30 +48,151 @@ func main() {
 	logger = log.NewLogfmtLogger(log.NewSyncWriter(os.Stderr))
 	logger = log.With(logger, "ts", log.DefaultTimestampUTC)
 
-	mongoClient, err := mongo.NewClient(logger)
+	var (
+		games   game.Repository
+		tickets ticket.Repository
+		tx      game.Transactor
+		players player.Repository
+	)
+	switch *storeType {
+	case "memory":
+		games = inmem.NewGameRepository()
+		tickets = inmem.NewTicketRepository()
+		tx = inmem.NewTransactor(games, tickets)
+		players = inmem.NewPlayerRepository()
+	case "mongo":
+		mongoClient, err := mongo.NewClient(logger)
+		if err != nil {
+			panic("could not create mongo client")
+		}
+		if err := mongo.CheckTransactions(context.Background(), mongoClient); err != nil {
+			panic(err)
+		}
+		if err := mongo.Migrate(context.Background(), mongoClient); err != nil {
+			panic(err)
+		}
+		storeLatency := kitprometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: "bingo",
+			Subsystem: "mongo",
+			Name:      "request_duration_seconds",
+			Help:      "Duration of calls to repositories in seconds.",
+			Buckets:   stdprometheus.DefBuckets,
+		}, []string{"repository", "method"})
+		games = game.NewInstrumentingRepository(storeLatency, mongo.NewGameRepository(mongoClient))
+		tickets = ticket.NewInstrumentingRepository(storeLatency, mongo.NewTicketRepository(mongoClient))
+		tx = game.NewInstrumentingTransactor(storeLatency, mongo.NewTransactor(mongoClient))
+		players = player.NewInstrumentingRepository(storeLatency, mongo.NewPlayerRepository(mongoClient))
+	default:
+		panic("unknown store: " + *storeType)
+	}
+	var rng bingo.Source
+	switch *rngType {
+	case "crypto":
+		rng = bingo.NewCryptoSource()
+	case "seeded":
+		rng = bingo.NewSeededSource(*rngSeed)
+	case "commit":
+		rng = bingo.NewCommitRevealSource()
+	default:
+		panic("unknown rng: " + *rngType)
+	}
 
-	if err != nil {
-		panic("could not create mongo client")
+	gameMetrics := bingo.Metrics{
+		GamesCreated: kitprometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: "bingo",
+			Subsystem: "game",
+			Name:      "games_created_total",
+			Help:      "Number of games created.",
+		}, nil),
+		TicketsIssued: kitprometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: "bingo",
+			Subsystem: "game",
+			Name:      "tickets_issued_total",
+			Help:      "Number of tickets issued, counting each ticket of a strip.",
+		}, nil),
+		NumbersDrawn: kitprometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: "bingo",
+			Subsystem: "game",
+			Name:      "numbers_drawn_total",
+			Help:      "Number of numbers drawn, by hosts and automatically.",
+		}, nil),
 	}
+	// games which have not finished are counted in the store on every scrape, so every instance reports the
+	// count of all games rather than of those it created or ended itself
+	stdprometheus.MustRegister(stdprometheus.NewGaugeFunc(stdprometheus.GaugeOpts{
+		Namespace: "bingo",
+		Subsystem: "game",
+		Name:      "active_games",
+		Help:      "Number of games which have not finished, across all instances.",
+	}, func() float64 {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		n, err := games.CountActive(ctx)
+		if err != nil {
+			logger.Log("msg", "could not count active games", "err", err)
+			return math.NaN()
+		}
+		return float64(n)
+	}))
+
+	fieldKeys := []string{"method"}
 
-	var (
-		games   = mongo.NewGameRepository(mongoClient)
-		tickets = mongo.NewTicketRepository(mongoClient)
-	)
 	var bs bingo.Service
-	bs = bingo.NewService(games, tickets)
+	opts := []bingo.Option{bingo.WithMetrics(gameMetrics)}
+	if *statsMin > 0 {
+		opts = append(opts, bingo.WithStatsCache(*statsMin))
+	}
+	bs = bingo.NewService(games, tickets, tx, rng, opts...)
+	if err := bingo.ResumeAutoCalls(context.Background(), bs, games); err != nil {
+		logger.Log("msg", "could not resume auto-calling games", "err", err)
+	}
+	bs = bingo.NewValidatingService(bs)
+	bs = bingo.NewInstrumentingService(
+		kitprometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: "bingo",
+			Subsystem: "service",
+			Name:      "requests_total",
+			Help:      "Number of requests received.",
+		}, fieldKeys),
+		kitprometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: "bingo",
+			Subsystem: "service",
+			Name:      "request_duration_seconds",
+			Help:      "Duration of requests in seconds.",
+			Buckets:   stdprometheus.DefBuckets,
+		}, fieldKeys),
+		bs,
+	)
+
+	var as account.Service
+	as = account.NewService(players)
 
 	httpLogger := log.With(logger, "component", "http")
 
 	mux := http.NewServeMux()
-	mux.Handle("/", bingo.MakeHandler(bs, httpLogger))
-	errs := make(chan error, 2)
+	mux.Handle("/api/player/", account.MakeHandler(as, httpLogger))
+	mux.Handle("/", bingo.MakeHandler(bs, as, httpLogger))
+	mux.Handle("/metrics", promhttp.Handler())
+	errs := make(chan error, 3)
 	go func() {
 		logger.Log("transport", "http", "address", *httpAddr, "msg", "listening")
 		errs <- http.ListenAndServe(*httpAddr, mux)
 	}()
+	if *grpcAddr != "" {
+		grpcServer := grpc.NewServer()
+		pb.RegisterBingoServer(grpcServer, bingo.MakeGRPCServer(bs, as, log.With(logger, "component", "grpc")))
+		go func() {
+			lis, err := net.Listen("tcp", *grpcAddr)
+			if err != nil {
+				errs <- err
+				return
+			}
+			logger.Log("transport", "grpc", "address", *grpcAddr, "msg", "listening")
+			errs <- grpcServer.Serve(lis)
+		}()
+	}
 	go func() {
-		c := make(chan os.Signal)
+		c := make(chan os.Signal, 1)
 		signal.Notify(c, syscall.SIGINT)
 		errs <- fmt.Errorf("%s", <-c)
 	}()
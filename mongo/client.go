@@ -16,12 +16,9 @@ var (
 	database string
 )
 
-func init() {
-	database = config.Mongo().Database
-}
-
 // NewClient creates a new mongo client.
 func NewClient(logger kitlog.Logger) (*mongo.Client, error) {
+	database = config.Mongo().Database
 	client, err := mongo.NewClient(options.Client().ApplyURI(config.Mongo().URI()).SetReadPreference(readpref.Primary()))
 	if err != nil {
 		logger.Log("err", err, "msg", "mongo: error while creating mongo client")
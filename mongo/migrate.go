@@ -0,0 +1,192 @@
+package mongo
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/divyanshgaba/bingo/game"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Migrate upgrades documents stored by older versions of the service. It is safe to run more than once.
+func Migrate(ctx context.Context, client *mongo.Client) error {
+	games := client.Database(database).Collection(gameRepository{}.collection())
+	tickets := client.Database(database).Collection(ticketRepository{}.collection())
+	if err := migrateGameStatus(ctx, games); err != nil {
+		return err
+	}
+	if err := migrateTicketGame(ctx, games, tickets); err != nil {
+		return err
+	}
+	if err := migrateDraws(ctx, games); err != nil {
+		return err
+	}
+	if err := migrateUsernameCase(ctx, client.Database(database)); err != nil {
+		return err
+	}
+	_, err := tickets.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "game", Value: 1}, {Key: "_id", Value: 1}}},
+		{Keys: bson.D{{Key: "game", Value: 1}, {Key: "username", Value: 1}}},
+	})
+	if err != nil {
+		return err
+	}
+	sessions := client.Database(database).Collection(playerRepository{}.sessionCollection())
+	_, err = sessions.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "expires_at", Value: 1}},
+		Options: options.Index().SetExpireAfterSeconds(0),
+	})
+	return err
+}
+
+// migrateGameStatus sets status on games created before games had a lifecycle,
+// derived from the numbers drawn and prizes won so far.
+func migrateGameStatus(ctx context.Context, c *mongo.Collection) error {
+	noStatus := bson.M{"status": bson.M{"$exists": false}}
+	steps := []struct {
+		query  bson.M
+		status game.Status
+	}{
+		{bson.M{"$or": bson.A{
+			bson.M{"numbers.99": bson.M{"$exists": true}},
+			bson.M{"winners.prize": game.FullHouse},
+		}}, game.Finished},
+		{bson.M{"numbers.0": bson.M{"$exists": true}}, game.Running},
+		{bson.M{}, game.Lobby},
+	}
+	for _, step := range steps {
+		query := bson.M{"$and": bson.A{noStatus, step.query}}
+		update := bson.M{"$set": bson.M{"status": step.status}}
+		if _, err := c.UpdateMany(ctx, query, update); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// migrateTicketGame sets the game reference on tickets created before tickets referenced their game,
+// from tickets listed on each game.
+func migrateTicketGame(ctx context.Context, games, tickets *mongo.Collection) error {
+	cur, err := games.Find(ctx, bson.M{"tickets.0": bson.M{"$exists": true}})
+	if err != nil {
+		return err
+	}
+	defer cur.Close(ctx)
+	for cur.Next(ctx) {
+		var g Game
+		if err := cur.Decode(&g); err != nil {
+			return err
+		}
+		ids := make(bson.A, 0, len(g.Tickets))
+		for _, id := range g.Tickets {
+			if oid, err := primitive.ObjectIDFromHex(string(id)); err == nil {
+				ids = append(ids, oid)
+			}
+		}
+		query := bson.M{"_id": bson.M{"$in": ids}, "game": bson.M{"$exists": false}}
+		update := bson.M{"$set": bson.M{"game": g.ID.Hex()}}
+		if _, err := tickets.UpdateMany(ctx, query, update); err != nil {
+			return err
+		}
+	}
+	return cur.Err()
+}
+
+// migrateDraws records draws of games which stored bare numbers drawn, and times for the last of them. Numbers
+// stored by an older instance of the service after a game was migrated, during a rolling deploy, are appended to
+// its draws rather than replacing them. Numbers already drawn are dropped.
+func migrateDraws(ctx context.Context, c *mongo.Collection) error {
+	cur, err := c.Find(ctx, bson.M{"numbers.0": bson.M{"$exists": true}})
+	if err != nil {
+		return err
+	}
+	defer cur.Close(ctx)
+	for cur.Next(ctx) {
+		var g struct {
+			ID      primitive.ObjectID `bson:"_id"`
+			Numbers []int64            `bson:"numbers"`
+			DrawnAt []time.Time        `bson:"drawn_at"`
+			Draws   []Draw             `bson:"draws"`
+		}
+		if err := cur.Decode(&g); err != nil {
+			return err
+		}
+		drawn := make(map[int64]bool, len(g.Draws))
+		for _, d := range g.Draws {
+			drawn[d.Number] = true
+		}
+		var draws []Draw
+		for i, n := range g.Numbers {
+			if drawn[n] {
+				continue
+			}
+			drawn[n] = true
+			d := Draw{Seq: len(g.Draws) + len(draws) + 1, Number: n}
+			// times were recorded for the last len(DrawnAt) numbers
+			if j := i - len(g.Numbers) + len(g.DrawnAt); j >= 0 {
+				d.DrawnAt = g.DrawnAt[j]
+			}
+			draws = append(draws, d)
+		}
+		pulled := bson.M{"numbers": g.Numbers}
+		if len(g.DrawnAt) > 0 {
+			pulled["drawn_at"] = g.DrawnAt
+		}
+		update := bson.M{"$pullAll": pulled}
+		if len(draws) > 0 {
+			update["$push"] = bson.M{"draws": bson.M{"$each": draws}}
+		}
+		if _, err := c.UpdateOne(ctx, bson.M{"_id": g.ID}, update); err != nil {
+			return err
+		}
+	}
+	return cur.Err()
+}
+
+// migrateUsernameCase renames players registered before usernames were lower case, along with their tickets and
+// the games they host. Their sessions are removed, so they log in again. A player whose lower case username is
+// taken already is left as it is.
+func migrateUsernameCase(ctx context.Context, db *mongo.Database) error {
+	players := db.Collection(playerRepository{}.collection())
+	cur, err := players.Find(ctx, bson.M{"_id": primitive.Regex{Pattern: "[A-Z]"}})
+	if err != nil {
+		return err
+	}
+	defer cur.Close(ctx)
+	for cur.Next(ctx) {
+		var p Player
+		if err := cur.Decode(&p); err != nil {
+			return err
+		}
+		old := p.Username
+		p.Username = strings.ToLower(old)
+		if _, err := players.InsertOne(ctx, p); isDuplicateKey(err) {
+			continue
+		} else if err != nil {
+			return err
+		}
+		renames := []struct {
+			collection, field string
+		}{
+			{ticketRepository{}.collection(), "username"},
+			{gameRepository{}.collection(), "host"},
+		}
+		for _, r := range renames {
+			update := bson.M{"$set": bson.M{r.field: p.Username}}
+			if _, err := db.Collection(r.collection).UpdateMany(ctx, bson.M{r.field: old}, update); err != nil {
+				return err
+			}
+		}
+		if _, err := db.Collection(playerRepository{}.sessionCollection()).DeleteMany(ctx, bson.M{"username": old}); err != nil {
+			return err
+		}
+		if _, err := players.DeleteOne(ctx, bson.M{"_id": old}); err != nil {
+			return err
+		}
+	}
+	return cur.Err()
+}
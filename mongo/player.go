@@ -0,0 +1,100 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/divyanshgaba/bingo/player"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type playerRepository struct {
+	client *mongo.Client
+}
+
+// NewPlayerRepository returns implementation for player.Repository with mongo store.
+func NewPlayerRepository(client *mongo.Client) player.Repository {
+	return &playerRepository{
+		client: client,
+	}
+}
+
+// Player is model for storing players, keyed by username.
+type Player struct {
+	Username     string    `bson:"_id"`
+	PasswordHash []byte    `bson:"password_hash"`
+	CreatedAt    time.Time `bson:"created_at"`
+}
+
+// Session is model for storing sessions of players, keyed by hash of the token.
+type Session struct {
+	TokenHash string    `bson:"_id"`
+	Username  string    `bson:"username"`
+	ExpiresAt time.Time `bson:"expires_at"`
+}
+
+func (r playerRepository) collection() string {
+	return "players"
+}
+
+func (r playerRepository) sessionCollection() string {
+	return "sessions"
+}
+
+func (r playerRepository) Insert(ctx context.Context, p player.Player) error {
+	c := r.client.Database(database).Collection(r.collection())
+	_, err := c.InsertOne(ctx, Player{Username: p.Username, PasswordHash: p.PasswordHash, CreatedAt: p.CreatedAt})
+	if isDuplicateKey(err) {
+		return player.ErrUsernameTaken
+	}
+	return err
+}
+
+func (r playerRepository) Find(ctx context.Context, username string) (player.Player, error) {
+	c := r.client.Database(database).Collection(r.collection())
+	var p Player
+	err := c.FindOne(ctx, bson.M{"_id": username}).Decode(&p)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return player.Player{}, player.ErrNotFound
+	}
+	if err != nil {
+		return player.Player{}, err
+	}
+	return player.Player{Username: p.Username, PasswordHash: p.PasswordHash, CreatedAt: p.CreatedAt}, nil
+}
+
+func (r playerRepository) InsertSession(ctx context.Context, s player.Session) error {
+	c := r.client.Database(database).Collection(r.sessionCollection())
+	_, err := c.InsertOne(ctx, Session{TokenHash: s.TokenHash, Username: s.Username, ExpiresAt: s.ExpiresAt})
+	return err
+}
+
+func (r playerRepository) FindSession(ctx context.Context, tokenHash string) (player.Session, error) {
+	c := r.client.Database(database).Collection(r.sessionCollection())
+	var s Session
+	// expired sessions are removed by a TTL index, which runs only once a minute
+	err := c.FindOne(ctx, bson.M{"_id": tokenHash, "expires_at": bson.M{"$gt": time.Now()}}).Decode(&s)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return player.Session{}, player.ErrInvalidToken
+	}
+	if err != nil {
+		return player.Session{}, err
+	}
+	return player.Session{TokenHash: s.TokenHash, Username: s.Username, ExpiresAt: s.ExpiresAt}, nil
+}
+
+// isDuplicateKey reports whether err is a write error for a duplicate key.
+func isDuplicateKey(err error) bool {
+	we, ok := err.(mongo.WriteException)
+	if !ok {
+		return false
+	}
+	for _, e := range we.WriteErrors {
+		if e.Code == 11000 {
+			return true
+		}
+	}
+	return false
+}
@@ -3,12 +3,15 @@ package mongo
 import (
 	"context"
 	"errors"
+	"strconv"
+	"time"
 
 	"github.com/divyanshgaba/bingo/game"
 	"github.com/divyanshgaba/bingo/ticket"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 type gameRepository struct {
@@ -24,9 +27,149 @@ func NewGameRepository(client *mongo.Client) game.Repository {
 
 // Game is model for storing games.
 type Game struct {
-	ID      primitive.ObjectID `bson:"_id,omitempty"`
-	Tickets []ticket.ID        `bson:"tickets,omitempty"`
-	Numbers []int64            `bson:"numbers,omitempty"`
+	ID     primitive.ObjectID `bson:"_id,omitempty"`
+	Status game.Status        `bson:"status,omitempty"`
+	Format *Format            `bson:"format,omitempty"`
+	Rules  *Rules             `bson:"rules,omitempty"`
+	Host   string             `bson:"host,omitempty"`
+	// HostSecretHash is hash of the secret for host operations.
+	HostSecretHash string      `bson:"host_secret_hash,omitempty"`
+	Tickets        []ticket.ID `bson:"tickets,omitempty"`
+	Draws          []Draw      `bson:"draws,omitempty"`
+	Winners        []Winner    `bson:"winners,omitempty"`
+	Seed           string      `bson:"seed,omitempty"`
+	SeedHash       string      `bson:"seed_hash,omitempty"`
+	// AutoCall is the interval numbers are drawn at automatically, in nanoseconds.
+	AutoCall time.Duration `bson:"auto_call,omitempty"`
+	Calls    *Calls        `bson:"calls,omitempty"`
+}
+
+// Format is model for storing format of a game.
+type Format struct {
+	Name    string `bson:"name"`
+	Rows    int    `bson:"rows"`
+	Cols    int    `bson:"cols"`
+	Min     int64  `bson:"min"`
+	Max     int64  `bson:"max"`
+	Numbers int    `bson:"numbers"`
+}
+
+func storeFormat(f game.Format) *Format {
+	if f == (game.Format{}) {
+		return nil
+	}
+	return &Format{Name: f.Name, Rows: f.Rows, Cols: f.Cols, Min: f.Min, Max: f.Max, Numbers: f.Numbers}
+}
+
+func gameFormat(f *Format) game.Format {
+	if f == nil {
+		return game.Format{}
+	}
+	return game.Format{Name: f.Name, Rows: f.Rows, Cols: f.Cols, Min: f.Min, Max: f.Max, Numbers: f.Numbers}
+}
+
+// Rules is model for storing rules of a game.
+type Rules struct {
+	MaxTicketsPerUser int              `bson:"max_tickets_per_user,omitempty"`
+	MaxTickets        int              `bson:"max_tickets,omitempty"`
+	SalesCutoff       game.SalesCutoff `bson:"sales_cutoff,omitempty"`
+}
+
+func storeRules(r game.Rules) *Rules {
+	if r == (game.Rules{}) {
+		return nil
+	}
+	return &Rules{MaxTicketsPerUser: r.MaxTicketsPerUser, MaxTickets: r.MaxTickets, SalesCutoff: r.SalesCutoff}
+}
+
+func gameRules(r *Rules) game.Rules {
+	if r == nil {
+		return game.Rules{}
+	}
+	return game.Rules{MaxTicketsPerUser: r.MaxTicketsPerUser, MaxTickets: r.MaxTickets, SalesCutoff: r.SalesCutoff}
+}
+
+// Calls is model for storing how numbers of a game are announced, custom call names are keyed by number.
+type Calls struct {
+	Locale string            `bson:"locale,omitempty"`
+	Custom map[string]string `bson:"custom,omitempty"`
+}
+
+func storeCalls(c game.Calls) *Calls {
+	if c.Locale == "" && len(c.Custom) == 0 {
+		return nil
+	}
+	calls := &Calls{Locale: c.Locale}
+	if len(c.Custom) > 0 {
+		calls.Custom = make(map[string]string, len(c.Custom))
+		for n, name := range c.Custom {
+			calls.Custom[strconv.FormatInt(n, 10)] = name
+		}
+	}
+	return calls
+}
+
+func gameCalls(c *Calls) game.Calls {
+	if c == nil {
+		return game.Calls{}
+	}
+	calls := game.Calls{Locale: c.Locale}
+	if len(c.Custom) > 0 {
+		calls.Custom = make(map[int64]string, len(c.Custom))
+		for n, name := range c.Custom {
+			if number, err := strconv.ParseInt(n, 10, 64); err == nil {
+				calls.Custom[number] = name
+			}
+		}
+	}
+	return calls
+}
+
+// Draw is model for storing numbers drawn in a game.
+type Draw struct {
+	Seq     int       `bson:"seq"`
+	Number  int64     `bson:"number"`
+	DrawnAt time.Time `bson:"drawn_at,omitempty"`
+	Caller  string    `bson:"caller,omitempty"`
+}
+
+func storeDraw(d game.Draw) Draw {
+	return Draw{Seq: d.Seq, Number: d.Number, DrawnAt: d.DrawnAt, Caller: d.Caller}
+}
+
+// storeDraws returns draws of game g, made up from its numbers if draws were not recorded.
+func storeDraws(g game.Game) []Draw {
+	ds := g.Draws
+	if len(ds) != len(g.Numbers) {
+		ds = game.DrawsOf(g.Numbers)
+	}
+	draws := make([]Draw, len(ds))
+	for i, d := range ds {
+		draws[i] = storeDraw(d)
+	}
+	return draws
+}
+
+func gameDraws(ds []Draw) []game.Draw {
+	draws := make([]game.Draw, len(ds))
+	for i, d := range ds {
+		draws[i] = game.Draw{Seq: d.Seq, Number: d.Number, DrawnAt: d.DrawnAt, Caller: d.Caller}
+	}
+	return draws
+}
+
+func gameNumbers(ds []Draw) []int64 {
+	numbers := make([]int64, len(ds))
+	for i, d := range ds {
+		numbers[i] = d.Number
+	}
+	return numbers
+}
+
+// Winner is model for storing winners of a game.
+type Winner struct {
+	Prize  game.Prize `bson:"prize"`
+	Ticket ticket.ID  `bson:"ticket"`
 }
 
 func (r gameRepository) collection() string {
@@ -34,7 +177,7 @@ func (r gameRepository) collection() string {
 }
 
 func (r gameRepository) Insert(ctx context.Context, g game.Game) (game.ID, error) {
-	gs := Game{Tickets: g.Tickets, Numbers: g.Numbers} // game store
+	gs := Game{Status: g.Status, Format: storeFormat(g.Format), Rules: storeRules(g.Rules), Host: g.Host, HostSecretHash: g.HostSecretHash, Tickets: g.Tickets, Draws: storeDraws(g), Seed: g.Seed, SeedHash: g.SeedHash, Calls: storeCalls(g.Calls)} // game store
 	c := r.client.Database(database).Collection(r.collection())
 	ior, err := c.InsertOne(ctx, gs)
 	if err != nil {
@@ -65,20 +208,35 @@ func (r gameRepository) Find(ctx context.Context, gameID game.ID) (game.Game, er
 			return game.Game{}, err
 		}
 	}
+	return domainGame(g), nil
+}
+
+func domainGame(g Game) game.Game {
 	return game.Game{
-		ID:      gameID,
-		Tickets: g.Tickets,
-		Numbers: g.Numbers,
-	}, nil
+		ID:             game.ID(g.ID.Hex()),
+		Status:         g.Status,
+		Format:         gameFormat(g.Format),
+		Rules:          gameRules(g.Rules),
+		Host:           g.Host,
+		HostSecretHash: g.HostSecretHash,
+		Tickets:        g.Tickets,
+		Numbers:        gameNumbers(g.Draws),
+		Draws:          gameDraws(g.Draws),
+		Winners:        gameWinners(g.Winners),
+		Seed:           g.Seed,
+		SeedHash:       g.SeedHash,
+		AutoCall:       g.AutoCall,
+		Calls:          gameCalls(g.Calls),
+	}
 }
-func (r gameRepository) AddTicket(ctx context.Context, gameID game.ID, ticketID ticket.ID) error {
+func (r gameRepository) AddTicket(ctx context.Context, gameID game.ID, ticketIDs ...ticket.ID) error {
 	c := r.client.Database(database).Collection(r.collection())
 	gameObjID, err := primitive.ObjectIDFromHex(string(gameID))
 	if err != nil {
 		return game.ErrInvalidID
 	}
 	query := bson.M{"_id": gameObjID}
-	update := bson.M{"$push": bson.M{"tickets": ticketID}}
+	update := bson.M{"$push": bson.M{"tickets": bson.M{"$each": ticketIDs}}}
 	ur, err := c.UpdateOne(ctx, query, update)
 	if err != nil {
 		return err
@@ -88,24 +246,153 @@ func (r gameRepository) AddTicket(ctx context.Context, gameID game.ID, ticketID
 	}
 	return err
 }
-func (r gameRepository) AddNumber(ctx context.Context, gameID game.ID, number int64) error {
+func (r gameRepository) AddNumber(ctx context.Context, gameID game.ID, d game.Draw) error {
 	c := r.client.Database(database).Collection(r.collection())
 	gameObjID, err := primitive.ObjectIDFromHex(string(gameID))
 	if err != nil {
 		return game.ErrInvalidID
 	}
-	query := bson.M{"_id": gameObjID}
-	update := bson.M{"$push": bson.M{"numbers": number}}
+	// only match a running game with exactly `drawn` numbers, none of which is the number drawn
+	drawn := d.Seq - 1
+	query := bson.M{
+		"_id":                          gameObjID,
+		"status":                       game.Running,
+		"draws.number":                 bson.M{"$ne": d.Number},
+		"draws." + strconv.Itoa(drawn): bson.M{"$exists": false},
+	}
+	if drawn > 0 {
+		query["draws."+strconv.Itoa(drawn-1)] = bson.M{"$exists": true}
+	}
+	update := bson.M{"$push": bson.M{"draws": storeDraw(d)}}
 	ur, err := c.UpdateOne(ctx, query, update)
 	if err != nil {
 		return err
 	}
 	if ur.MatchedCount < 1 {
+		if _, err := r.Find(ctx, gameID); err != nil {
+			return err
+		}
+		return game.ErrConcurrentUpdate
+	}
+	return err
+}
+
+func (r gameRepository) AddWinner(ctx context.Context, gameID game.ID, w game.Winner) error {
+	c := r.client.Database(database).Collection(r.collection())
+	gameObjID, err := primitive.ObjectIDFromHex(string(gameID))
+	if err != nil {
 		return game.ErrInvalidID
 	}
+	// only match the game if nobody has claimed this prize yet
+	query := bson.M{"_id": gameObjID, "winners.prize": bson.M{"$ne": w.Prize}}
+	update := bson.M{"$push": bson.M{"winners": Winner{Prize: w.Prize, Ticket: w.Ticket}}}
+	ur, err := c.UpdateOne(ctx, query, update)
+	if err != nil {
+		return err
+	}
+	if ur.MatchedCount < 1 {
+		if _, err := r.Find(ctx, gameID); err != nil {
+			return err
+		}
+		return game.ErrPrizeClaimed
+	}
+	return err
+}
+
+func (r gameRepository) SetStatus(ctx context.Context, gameID game.ID, from, to game.Status) error {
+	c := r.client.Database(database).Collection(r.collection())
+	gameObjID, err := primitive.ObjectIDFromHex(string(gameID))
+	if err != nil {
+		return game.ErrInvalidID
+	}
+	query := bson.M{"_id": gameObjID, "status": from}
+	update := bson.M{"$set": bson.M{"status": to}}
+	ur, err := c.UpdateOne(ctx, query, update)
+	if err != nil {
+		return err
+	}
+	if ur.MatchedCount < 1 {
+		if _, err := r.Find(ctx, gameID); err != nil {
+			return err
+		}
+		return game.ErrInvalidTransition
+	}
 	return err
 }
 
+func (r gameRepository) SetAutoCall(ctx context.Context, gameID game.ID, interval time.Duration) error {
+	c := r.client.Database(database).Collection(r.collection())
+	gameObjID, err := primitive.ObjectIDFromHex(string(gameID))
+	if err != nil {
+		return game.ErrInvalidID
+	}
+	update := bson.M{"$set": bson.M{"auto_call": interval}}
+	if interval == 0 {
+		update = bson.M{"$unset": bson.M{"auto_call": ""}}
+	}
+	ur, err := c.UpdateOne(ctx, bson.M{"_id": gameObjID}, update)
+	if err != nil {
+		return err
+	}
+	if ur.MatchedCount < 1 {
+		return game.ErrInvalidID
+	}
+	return nil
+}
+
+func (r gameRepository) SetCalls(ctx context.Context, gameID game.ID, calls game.Calls) error {
+	c := r.client.Database(database).Collection(r.collection())
+	gameObjID, err := primitive.ObjectIDFromHex(string(gameID))
+	if err != nil {
+		return game.ErrInvalidID
+	}
+	stored := storeCalls(calls)
+	update := bson.M{"$set": bson.M{"calls": stored}}
+	if stored == nil {
+		update = bson.M{"$unset": bson.M{"calls": ""}}
+	}
+	ur, err := c.UpdateOne(ctx, bson.M{"_id": gameObjID}, update)
+	if err != nil {
+		return err
+	}
+	if ur.MatchedCount < 1 {
+		return game.ErrInvalidID
+	}
+	return nil
+}
+
+func (r gameRepository) FindAutoCalled(ctx context.Context) ([]game.Game, error) {
+	c := r.client.Database(database).Collection(r.collection())
+	cur, err := c.Find(ctx, bson.M{"auto_call": bson.M{"$gt": 0}})
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+	var games []game.Game
+	for cur.Next(ctx) {
+		var g Game
+		if err := cur.Decode(&g); err != nil {
+			return nil, err
+		}
+		games = append(games, domainGame(g))
+	}
+	return games, cur.Err()
+}
+
+func (r gameRepository) CountActive(ctx context.Context) (int, error) {
+	c := r.client.Database(database).Collection(r.collection())
+	n, err := c.CountDocuments(ctx, bson.M{"status": bson.M{"$ne": game.Finished}})
+	return int(n), err
+}
+
+func gameWinners(ws []Winner) []game.Winner {
+	winners := make([]game.Winner, len(ws))
+	for i, w := range ws {
+		winners[i] = game.Winner{Prize: w.Prize, Ticket: w.Ticket}
+	}
+	return winners
+}
+
 func ticketIDSlice(ids []primitive.ObjectID) []ticket.ID {
 	hexIDs := make([]ticket.ID, len(ids))
 	for i, id := range ids {
@@ -127,9 +414,52 @@ func NewTicketRepository(client *mongo.Client) ticket.Repository {
 
 // Ticket is model for storing tickets.
 type Ticket struct {
-	ID       primitive.ObjectID `bson:"_id,omitempty"`
-	Username string             `bson:"username"`
-	Cells    string             `bson:"cell"`
+	ID        primitive.ObjectID `bson:"_id,omitempty"`
+	GameID    string             `bson:"game,omitempty"`
+	StripID   ticket.StripID     `bson:"strip,omitempty"`
+	Username  string             `bson:"username"`
+	Rows      int                `bson:"rows,omitempty"`
+	Cols      int                `bson:"cols,omitempty"`
+	Cells     string             `bson:"cell"`
+	Nonce     string             `bson:"nonce,omitempty"`
+	Marked    []int64            `bson:"marked,omitempty"`
+	AutoDaub  bool               `bson:"auto_daub,omitempty"`
+	CreatedAt time.Time          `bson:"created_at,omitempty"`
+}
+
+func storeTicket(t ticket.Ticket) Ticket {
+	return Ticket{
+		GameID:    t.GameID,
+		StripID:   t.StripID,
+		Username:  t.Username,
+		Rows:      t.Rows,
+		Cols:      t.Cols,
+		Cells:     t.Cells,
+		Nonce:     t.Nonce,
+		Marked:    t.Marked,
+		AutoDaub:  t.AutoDaub,
+		CreatedAt: time.Now().UTC(),
+	}
+}
+
+func domainTicket(t Ticket) ticket.Ticket {
+	createdAt := t.CreatedAt
+	if createdAt.IsZero() { // stored before creation time was recorded
+		createdAt = t.ID.Timestamp().UTC()
+	}
+	return ticket.Ticket{
+		ID:        ticket.ID(t.ID.Hex()),
+		GameID:    t.GameID,
+		StripID:   t.StripID,
+		Username:  t.Username,
+		Rows:      t.Rows,
+		Cols:      t.Cols,
+		Cells:     t.Cells,
+		Nonce:     t.Nonce,
+		Marked:    t.Marked,
+		AutoDaub:  t.AutoDaub,
+		CreatedAt: createdAt,
+	}
 }
 
 func (r ticketRepository) collection() string {
@@ -137,7 +467,7 @@ func (r ticketRepository) collection() string {
 }
 
 func (r ticketRepository) Insert(ctx context.Context, t ticket.Ticket) (ticket.ID, error) {
-	ts := Ticket{Username: t.Username, Cells: t.Cells} // ticket store
+	ts := storeTicket(t) // ticket store
 	c := r.client.Database(database).Collection(r.collection())
 	ior, err := c.InsertOne(ctx, ts)
 	if err != nil {
@@ -168,9 +498,85 @@ func (r ticketRepository) Find(ctx context.Context, ticketID ticket.ID) (ticket.
 			return ticket.Ticket{}, err
 		}
 	}
-	return ticket.Ticket{
-		ID:       ticket.ID(t.ID.Hex()),
-		Username: t.Username,
-		Cells:    t.Cells,
-	}, nil
+	return domainTicket(t), nil
+}
+
+func (r ticketRepository) InsertStrip(ctx context.Context, ts []ticket.Ticket) (ticket.StripID, []ticket.ID, error) {
+	stripID := ticket.StripID(primitive.NewObjectID().Hex())
+	docs := make([]interface{}, len(ts))
+	ids := make([]ticket.ID, len(ts))
+	for i, t := range ts {
+		doc := storeTicket(t)
+		doc.ID, doc.StripID = primitive.NewObjectID(), stripID
+		docs[i] = doc
+		ids[i] = ticket.ID(doc.ID.Hex())
+	}
+	c := r.client.Database(database).Collection(r.collection())
+	if _, err := c.InsertMany(ctx, docs); err != nil {
+		// remove tickets inserted before the failure, a strip is sold whole or not at all
+		c.DeleteMany(ctx, bson.M{"strip": stripID})
+		return "", nil, err
+	}
+	return stripID, ids, nil
+}
+
+func (r ticketRepository) Mark(ctx context.Context, ticketID ticket.ID, number int64) error {
+	return r.update(ctx, ticketID, bson.M{"$addToSet": bson.M{"marked": number}})
+}
+
+func (r ticketRepository) Unmark(ctx context.Context, ticketID ticket.ID, number int64) error {
+	return r.update(ctx, ticketID, bson.M{"$pull": bson.M{"marked": number}})
+}
+
+func (r ticketRepository) SetAutoDaub(ctx context.Context, ticketID ticket.ID, enabled bool) error {
+	return r.update(ctx, ticketID, bson.M{"$set": bson.M{"auto_daub": enabled}})
+}
+
+func (r ticketRepository) FindByGame(ctx context.Context, gameID string, offset, limit int) ([]ticket.Ticket, int, error) {
+	c := r.client.Database(database).Collection(r.collection())
+	query := bson.M{"game": gameID}
+	total, err := c.CountDocuments(ctx, query)
+	if err != nil {
+		return nil, 0, err
+	}
+	ts := []ticket.Ticket{}
+	if limit == 0 {
+		return ts, int(total), nil
+	}
+	opts := options.Find().SetSort(bson.M{"_id": 1}).SetSkip(int64(offset)).SetLimit(int64(limit))
+	cur, err := c.Find(ctx, query, opts)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer cur.Close(ctx)
+	for cur.Next(ctx) {
+		var t Ticket
+		if err := cur.Decode(&t); err != nil {
+			return nil, 0, err
+		}
+		ts = append(ts, domainTicket(t))
+	}
+	return ts, int(total), cur.Err()
+}
+
+func (r ticketRepository) CountByUser(ctx context.Context, gameID, username string) (int, error) {
+	c := r.client.Database(database).Collection(r.collection())
+	count, err := c.CountDocuments(ctx, bson.M{"game": gameID, "username": username})
+	return int(count), err
+}
+
+func (r ticketRepository) update(ctx context.Context, ticketID ticket.ID, update bson.M) error {
+	c := r.client.Database(database).Collection(r.collection())
+	ticketObjID, err := primitive.ObjectIDFromHex(string(ticketID))
+	if err != nil {
+		return ticket.ErrInvalidID
+	}
+	ur, err := c.UpdateOne(ctx, bson.M{"_id": ticketObjID}, update)
+	if err != nil {
+		return err
+	}
+	if ur.MatchedCount < 1 {
+		return ticket.ErrInvalidID
+	}
+	return nil
 }
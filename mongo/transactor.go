@@ -0,0 +1,85 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/divyanshgaba/bingo/game"
+	"github.com/divyanshgaba/bingo/ticket"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// ErrTransactionsUnsupported is returned when the mongo deployment is a standalone server, which cannot run
+// transactions.
+var ErrTransactionsUnsupported = errors.New("mongo: transactions need a replica set or a sharded cluster, a single node replica set is enough")
+
+type transactor struct {
+	client  *mongo.Client
+	games   game.Repository
+	tickets ticket.Repository
+
+	mtx     sync.Mutex
+	checked bool // whether the deployment is known to support transactions
+}
+
+// NewTransactor returns implementation for game.Transactor which runs units of work in mongo transactions.
+// Transactions need a replica set or a sharded cluster, on a standalone server units of work fail with
+// ErrTransactionsUnsupported rather than running without one, see CheckTransactions.
+func NewTransactor(client *mongo.Client) game.Transactor {
+	return &transactor{
+		client:  client,
+		games:   NewGameRepository(client),
+		tickets: NewTicketRepository(client),
+	}
+}
+
+func (t *transactor) WithinTransaction(ctx context.Context, fn func(context.Context, game.Repository, ticket.Repository) error) error {
+	if err := t.check(ctx); err != nil {
+		return err
+	}
+	sess, err := t.client.StartSession()
+	if err != nil {
+		return err
+	}
+	defer sess.EndSession(ctx)
+	// repositories pass the session context on to every operation, which makes them part of the transaction
+	_, err = sess.WithTransaction(ctx, func(sc mongo.SessionContext) (interface{}, error) {
+		return nil, fn(sc, t.games, t.tickets)
+	})
+	return err
+}
+
+// check returns ErrTransactionsUnsupported unless the deployment supports transactions. Once it does, it is
+// not checked again.
+func (t *transactor) check(ctx context.Context) error {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+	if t.checked {
+		return nil
+	}
+	if err := CheckTransactions(ctx, t.client); err != nil {
+		return err
+	}
+	t.checked = true
+	return nil
+}
+
+// CheckTransactions returns ErrTransactionsUnsupported unless the deployment client is connected to is a replica
+// set or a sharded cluster. Check it when the service starts, so it refuses to run on a standalone server.
+func CheckTransactions(ctx context.Context, client *mongo.Client) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	var reply struct {
+		SetName string `bson:"setName"`
+		Msg     string `bson:"msg"`
+	}
+	if err := client.Database("admin").RunCommand(ctx, bson.M{"isMaster": 1}).Decode(&reply); err != nil {
+		return err
+	}
+	if reply.SetName == "" && reply.Msg != "isdbgrid" {
+		return ErrTransactionsUnsupported
+	}
+	return nil
+}
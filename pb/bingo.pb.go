@@ -0,0 +1,3044 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: bingo.proto
+
+package pb
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	duration "github.com/golang/protobuf/ptypes/duration"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type GameRequest struct {
+	GameId               string   `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GameRequest) Reset()         { *m = GameRequest{} }
+func (m *GameRequest) String() string { return proto.CompactTextString(m) }
+func (*GameRequest) ProtoMessage()    {}
+func (*GameRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3437672f241df7a6, []int{0}
+}
+
+func (m *GameRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GameRequest.Unmarshal(m, b)
+}
+func (m *GameRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GameRequest.Marshal(b, m, deterministic)
+}
+func (m *GameRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GameRequest.Merge(m, src)
+}
+func (m *GameRequest) XXX_Size() int {
+	return xxx_messageInfo_GameRequest.Size(m)
+}
+func (m *GameRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GameRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GameRequest proto.InternalMessageInfo
+
+func (m *GameRequest) GetGameId() string {
+	if m != nil {
+		return m.GameId
+	}
+	return ""
+}
+
+type CreateGameRequest struct {
+	// format of tickets, classic when empty
+	Format            string `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"`
+	Rows              int32  `protobuf:"varint,2,opt,name=rows,proto3" json:"rows,omitempty"`
+	Cols              int32  `protobuf:"varint,3,opt,name=cols,proto3" json:"cols,omitempty"`
+	Min               int64  `protobuf:"varint,4,opt,name=min,proto3" json:"min,omitempty"`
+	Max               int64  `protobuf:"varint,5,opt,name=max,proto3" json:"max,omitempty"`
+	Numbers           int32  `protobuf:"varint,6,opt,name=numbers,proto3" json:"numbers,omitempty"`
+	MaxTicketsPerUser int32  `protobuf:"varint,7,opt,name=max_tickets_per_user,json=maxTicketsPerUser,proto3" json:"max_tickets_per_user,omitempty"`
+	MaxTickets        int32  `protobuf:"varint,8,opt,name=max_tickets,json=maxTickets,proto3" json:"max_tickets,omitempty"`
+	// when ticket sales close: "start" or "first_draw"
+	SalesCutoff          string   `protobuf:"bytes,9,opt,name=sales_cutoff,json=salesCutoff,proto3" json:"sales_cutoff,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateGameRequest) Reset()         { *m = CreateGameRequest{} }
+func (m *CreateGameRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateGameRequest) ProtoMessage()    {}
+func (*CreateGameRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3437672f241df7a6, []int{1}
+}
+
+func (m *CreateGameRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateGameRequest.Unmarshal(m, b)
+}
+func (m *CreateGameRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateGameRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateGameRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateGameRequest.Merge(m, src)
+}
+func (m *CreateGameRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateGameRequest.Size(m)
+}
+func (m *CreateGameRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateGameRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateGameRequest proto.InternalMessageInfo
+
+func (m *CreateGameRequest) GetFormat() string {
+	if m != nil {
+		return m.Format
+	}
+	return ""
+}
+
+func (m *CreateGameRequest) GetRows() int32 {
+	if m != nil {
+		return m.Rows
+	}
+	return 0
+}
+
+func (m *CreateGameRequest) GetCols() int32 {
+	if m != nil {
+		return m.Cols
+	}
+	return 0
+}
+
+func (m *CreateGameRequest) GetMin() int64 {
+	if m != nil {
+		return m.Min
+	}
+	return 0
+}
+
+func (m *CreateGameRequest) GetMax() int64 {
+	if m != nil {
+		return m.Max
+	}
+	return 0
+}
+
+func (m *CreateGameRequest) GetNumbers() int32 {
+	if m != nil {
+		return m.Numbers
+	}
+	return 0
+}
+
+func (m *CreateGameRequest) GetMaxTicketsPerUser() int32 {
+	if m != nil {
+		return m.MaxTicketsPerUser
+	}
+	return 0
+}
+
+func (m *CreateGameRequest) GetMaxTickets() int32 {
+	if m != nil {
+		return m.MaxTickets
+	}
+	return 0
+}
+
+func (m *CreateGameRequest) GetSalesCutoff() string {
+	if m != nil {
+		return m.SalesCutoff
+	}
+	return ""
+}
+
+type CreateGameReply struct {
+	GameId               string   `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	HostSecret           string   `protobuf:"bytes,2,opt,name=host_secret,json=hostSecret,proto3" json:"host_secret,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateGameReply) Reset()         { *m = CreateGameReply{} }
+func (m *CreateGameReply) String() string { return proto.CompactTextString(m) }
+func (*CreateGameReply) ProtoMessage()    {}
+func (*CreateGameReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3437672f241df7a6, []int{2}
+}
+
+func (m *CreateGameReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateGameReply.Unmarshal(m, b)
+}
+func (m *CreateGameReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateGameReply.Marshal(b, m, deterministic)
+}
+func (m *CreateGameReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateGameReply.Merge(m, src)
+}
+func (m *CreateGameReply) XXX_Size() int {
+	return xxx_messageInfo_CreateGameReply.Size(m)
+}
+func (m *CreateGameReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateGameReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateGameReply proto.InternalMessageInfo
+
+func (m *CreateGameReply) GetGameId() string {
+	if m != nil {
+		return m.GameId
+	}
+	return ""
+}
+
+func (m *CreateGameReply) GetHostSecret() string {
+	if m != nil {
+		return m.HostSecret
+	}
+	return ""
+}
+
+type AuthorizeHostRequest struct {
+	GameId               string   `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	HostSecret           string   `protobuf:"bytes,2,opt,name=host_secret,json=hostSecret,proto3" json:"host_secret,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AuthorizeHostRequest) Reset()         { *m = AuthorizeHostRequest{} }
+func (m *AuthorizeHostRequest) String() string { return proto.CompactTextString(m) }
+func (*AuthorizeHostRequest) ProtoMessage()    {}
+func (*AuthorizeHostRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3437672f241df7a6, []int{3}
+}
+
+func (m *AuthorizeHostRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuthorizeHostRequest.Unmarshal(m, b)
+}
+func (m *AuthorizeHostRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuthorizeHostRequest.Marshal(b, m, deterministic)
+}
+func (m *AuthorizeHostRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthorizeHostRequest.Merge(m, src)
+}
+func (m *AuthorizeHostRequest) XXX_Size() int {
+	return xxx_messageInfo_AuthorizeHostRequest.Size(m)
+}
+func (m *AuthorizeHostRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthorizeHostRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuthorizeHostRequest proto.InternalMessageInfo
+
+func (m *AuthorizeHostRequest) GetGameId() string {
+	if m != nil {
+		return m.GameId
+	}
+	return ""
+}
+
+func (m *AuthorizeHostRequest) GetHostSecret() string {
+	if m != nil {
+		return m.HostSecret
+	}
+	return ""
+}
+
+type AuthorizeHostReply struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AuthorizeHostReply) Reset()         { *m = AuthorizeHostReply{} }
+func (m *AuthorizeHostReply) String() string { return proto.CompactTextString(m) }
+func (*AuthorizeHostReply) ProtoMessage()    {}
+func (*AuthorizeHostReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3437672f241df7a6, []int{4}
+}
+
+func (m *AuthorizeHostReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuthorizeHostReply.Unmarshal(m, b)
+}
+func (m *AuthorizeHostReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuthorizeHostReply.Marshal(b, m, deterministic)
+}
+func (m *AuthorizeHostReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthorizeHostReply.Merge(m, src)
+}
+func (m *AuthorizeHostReply) XXX_Size() int {
+	return xxx_messageInfo_AuthorizeHostReply.Size(m)
+}
+func (m *AuthorizeHostReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthorizeHostReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuthorizeHostReply proto.InternalMessageInfo
+
+type CreateTicketRequest struct {
+	GameId               string   `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Username             string   `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateTicketRequest) Reset()         { *m = CreateTicketRequest{} }
+func (m *CreateTicketRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateTicketRequest) ProtoMessage()    {}
+func (*CreateTicketRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3437672f241df7a6, []int{5}
+}
+
+func (m *CreateTicketRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateTicketRequest.Unmarshal(m, b)
+}
+func (m *CreateTicketRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateTicketRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateTicketRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateTicketRequest.Merge(m, src)
+}
+func (m *CreateTicketRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateTicketRequest.Size(m)
+}
+func (m *CreateTicketRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateTicketRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateTicketRequest proto.InternalMessageInfo
+
+func (m *CreateTicketRequest) GetGameId() string {
+	if m != nil {
+		return m.GameId
+	}
+	return ""
+}
+
+func (m *CreateTicketRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+type CreateTicketReply struct {
+	TicketId             string   `protobuf:"bytes,1,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateTicketReply) Reset()         { *m = CreateTicketReply{} }
+func (m *CreateTicketReply) String() string { return proto.CompactTextString(m) }
+func (*CreateTicketReply) ProtoMessage()    {}
+func (*CreateTicketReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3437672f241df7a6, []int{6}
+}
+
+func (m *CreateTicketReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateTicketReply.Unmarshal(m, b)
+}
+func (m *CreateTicketReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateTicketReply.Marshal(b, m, deterministic)
+}
+func (m *CreateTicketReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateTicketReply.Merge(m, src)
+}
+func (m *CreateTicketReply) XXX_Size() int {
+	return xxx_messageInfo_CreateTicketReply.Size(m)
+}
+func (m *CreateTicketReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateTicketReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateTicketReply proto.InternalMessageInfo
+
+func (m *CreateTicketReply) GetTicketId() string {
+	if m != nil {
+		return m.TicketId
+	}
+	return ""
+}
+
+type CreateTicketStripReply struct {
+	StripId              string   `protobuf:"bytes,1,opt,name=strip_id,json=stripId,proto3" json:"strip_id,omitempty"`
+	TicketIds            []string `protobuf:"bytes,2,rep,name=ticket_ids,json=ticketIds,proto3" json:"ticket_ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateTicketStripReply) Reset()         { *m = CreateTicketStripReply{} }
+func (m *CreateTicketStripReply) String() string { return proto.CompactTextString(m) }
+func (*CreateTicketStripReply) ProtoMessage()    {}
+func (*CreateTicketStripReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3437672f241df7a6, []int{7}
+}
+
+func (m *CreateTicketStripReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateTicketStripReply.Unmarshal(m, b)
+}
+func (m *CreateTicketStripReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateTicketStripReply.Marshal(b, m, deterministic)
+}
+func (m *CreateTicketStripReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateTicketStripReply.Merge(m, src)
+}
+func (m *CreateTicketStripReply) XXX_Size() int {
+	return xxx_messageInfo_CreateTicketStripReply.Size(m)
+}
+func (m *CreateTicketStripReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateTicketStripReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateTicketStripReply proto.InternalMessageInfo
+
+func (m *CreateTicketStripReply) GetStripId() string {
+	if m != nil {
+		return m.StripId
+	}
+	return ""
+}
+
+func (m *CreateTicketStripReply) GetTicketIds() []string {
+	if m != nil {
+		return m.TicketIds
+	}
+	return nil
+}
+
+type ShowTicketRequest struct {
+	TicketId             string   `protobuf:"bytes,1,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ShowTicketRequest) Reset()         { *m = ShowTicketRequest{} }
+func (m *ShowTicketRequest) String() string { return proto.CompactTextString(m) }
+func (*ShowTicketRequest) ProtoMessage()    {}
+func (*ShowTicketRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3437672f241df7a6, []int{8}
+}
+
+func (m *ShowTicketRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ShowTicketRequest.Unmarshal(m, b)
+}
+func (m *ShowTicketRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ShowTicketRequest.Marshal(b, m, deterministic)
+}
+func (m *ShowTicketRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShowTicketRequest.Merge(m, src)
+}
+func (m *ShowTicketRequest) XXX_Size() int {
+	return xxx_messageInfo_ShowTicketRequest.Size(m)
+}
+func (m *ShowTicketRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShowTicketRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShowTicketRequest proto.InternalMessageInfo
+
+func (m *ShowTicketRequest) GetTicketId() string {
+	if m != nil {
+		return m.TicketId
+	}
+	return ""
+}
+
+// Ticket has cells row by row, -1 for an empty cell and 0 for a free cell.
+type Ticket struct {
+	Id        string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	GameId    string               `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	StripId   string               `protobuf:"bytes,3,opt,name=strip_id,json=stripId,proto3" json:"strip_id,omitempty"`
+	Username  string               `protobuf:"bytes,4,opt,name=username,proto3" json:"username,omitempty"`
+	Rows      int32                `protobuf:"varint,5,opt,name=rows,proto3" json:"rows,omitempty"`
+	Cols      int32                `protobuf:"varint,6,opt,name=cols,proto3" json:"cols,omitempty"`
+	Cells     []int64              `protobuf:"varint,7,rep,packed,name=cells,proto3" json:"cells,omitempty"`
+	Marked    []int64              `protobuf:"varint,8,rep,packed,name=marked,proto3" json:"marked,omitempty"`
+	Drawn     []int64              `protobuf:"varint,9,rep,packed,name=drawn,proto3" json:"drawn,omitempty"`
+	AutoDaub  bool                 `protobuf:"varint,10,opt,name=auto_daub,json=autoDaub,proto3" json:"auto_daub,omitempty"`
+	CreatedAt *timestamp.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// format of the game and nonce of the ticket, set once the seed of the game is revealed
+	Format               *Format  `protobuf:"bytes,12,opt,name=format,proto3" json:"format,omitempty"`
+	Nonce                string   `protobuf:"bytes,13,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Ticket) Reset()         { *m = Ticket{} }
+func (m *Ticket) String() string { return proto.CompactTextString(m) }
+func (*Ticket) ProtoMessage()    {}
+func (*Ticket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3437672f241df7a6, []int{9}
+}
+
+func (m *Ticket) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Ticket.Unmarshal(m, b)
+}
+func (m *Ticket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Ticket.Marshal(b, m, deterministic)
+}
+func (m *Ticket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Ticket.Merge(m, src)
+}
+func (m *Ticket) XXX_Size() int {
+	return xxx_messageInfo_Ticket.Size(m)
+}
+func (m *Ticket) XXX_DiscardUnknown() {
+	xxx_messageInfo_Ticket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Ticket proto.InternalMessageInfo
+
+func (m *Ticket) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Ticket) GetGameId() string {
+	if m != nil {
+		return m.GameId
+	}
+	return ""
+}
+
+func (m *Ticket) GetStripId() string {
+	if m != nil {
+		return m.StripId
+	}
+	return ""
+}
+
+func (m *Ticket) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *Ticket) GetRows() int32 {
+	if m != nil {
+		return m.Rows
+	}
+	return 0
+}
+
+func (m *Ticket) GetCols() int32 {
+	if m != nil {
+		return m.Cols
+	}
+	return 0
+}
+
+func (m *Ticket) GetCells() []int64 {
+	if m != nil {
+		return m.Cells
+	}
+	return nil
+}
+
+func (m *Ticket) GetMarked() []int64 {
+	if m != nil {
+		return m.Marked
+	}
+	return nil
+}
+
+func (m *Ticket) GetDrawn() []int64 {
+	if m != nil {
+		return m.Drawn
+	}
+	return nil
+}
+
+func (m *Ticket) GetAutoDaub() bool {
+	if m != nil {
+		return m.AutoDaub
+	}
+	return false
+}
+
+func (m *Ticket) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *Ticket) GetFormat() *Format {
+	if m != nil {
+		return m.Format
+	}
+	return nil
+}
+
+func (m *Ticket) GetNonce() string {
+	if m != nil {
+		return m.Nonce
+	}
+	return ""
+}
+
+type Format struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Rows                 int32    `protobuf:"varint,2,opt,name=rows,proto3" json:"rows,omitempty"`
+	Cols                 int32    `protobuf:"varint,3,opt,name=cols,proto3" json:"cols,omitempty"`
+	Min                  int64    `protobuf:"varint,4,opt,name=min,proto3" json:"min,omitempty"`
+	Max                  int64    `protobuf:"varint,5,opt,name=max,proto3" json:"max,omitempty"`
+	Numbers              int32    `protobuf:"varint,6,opt,name=numbers,proto3" json:"numbers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Format) Reset()         { *m = Format{} }
+func (m *Format) String() string { return proto.CompactTextString(m) }
+func (*Format) ProtoMessage()    {}
+func (*Format) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3437672f241df7a6, []int{10}
+}
+
+func (m *Format) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Format.Unmarshal(m, b)
+}
+func (m *Format) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Format.Marshal(b, m, deterministic)
+}
+func (m *Format) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Format.Merge(m, src)
+}
+func (m *Format) XXX_Size() int {
+	return xxx_messageInfo_Format.Size(m)
+}
+func (m *Format) XXX_DiscardUnknown() {
+	xxx_messageInfo_Format.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Format proto.InternalMessageInfo
+
+func (m *Format) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Format) GetRows() int32 {
+	if m != nil {
+		return m.Rows
+	}
+	return 0
+}
+
+func (m *Format) GetCols() int32 {
+	if m != nil {
+		return m.Cols
+	}
+	return 0
+}
+
+func (m *Format) GetMin() int64 {
+	if m != nil {
+		return m.Min
+	}
+	return 0
+}
+
+func (m *Format) GetMax() int64 {
+	if m != nil {
+		return m.Max
+	}
+	return 0
+}
+
+func (m *Format) GetNumbers() int32 {
+	if m != nil {
+		return m.Numbers
+	}
+	return 0
+}
+
+type ListTicketsRequest struct {
+	GameId               string   `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Offset               int32    `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit                int32    `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListTicketsRequest) Reset()         { *m = ListTicketsRequest{} }
+func (m *ListTicketsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListTicketsRequest) ProtoMessage()    {}
+func (*ListTicketsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3437672f241df7a6, []int{11}
+}
+
+func (m *ListTicketsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListTicketsRequest.Unmarshal(m, b)
+}
+func (m *ListTicketsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListTicketsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListTicketsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListTicketsRequest.Merge(m, src)
+}
+func (m *ListTicketsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListTicketsRequest.Size(m)
+}
+func (m *ListTicketsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListTicketsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListTicketsRequest proto.InternalMessageInfo
+
+func (m *ListTicketsRequest) GetGameId() string {
+	if m != nil {
+		return m.GameId
+	}
+	return ""
+}
+
+func (m *ListTicketsRequest) GetOffset() int32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *ListTicketsRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type ListTicketsReply struct {
+	Tickets              []*ListTicketsReply_Listing `protobuf:"bytes,1,rep,name=tickets,proto3" json:"tickets,omitempty"`
+	Total                int32                       `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Offset               int32                       `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *ListTicketsReply) Reset()         { *m = ListTicketsReply{} }
+func (m *ListTicketsReply) String() string { return proto.CompactTextString(m) }
+func (*ListTicketsReply) ProtoMessage()    {}
+func (*ListTicketsReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3437672f241df7a6, []int{12}
+}
+
+func (m *ListTicketsReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListTicketsReply.Unmarshal(m, b)
+}
+func (m *ListTicketsReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListTicketsReply.Marshal(b, m, deterministic)
+}
+func (m *ListTicketsReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListTicketsReply.Merge(m, src)
+}
+func (m *ListTicketsReply) XXX_Size() int {
+	return xxx_messageInfo_ListTicketsReply.Size(m)
+}
+func (m *ListTicketsReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListTicketsReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListTicketsReply proto.InternalMessageInfo
+
+func (m *ListTicketsReply) GetTickets() []*ListTicketsReply_Listing {
+	if m != nil {
+		return m.Tickets
+	}
+	return nil
+}
+
+func (m *ListTicketsReply) GetTotal() int32 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *ListTicketsReply) GetOffset() int32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+type ListTicketsReply_Listing struct {
+	TicketId             string               `protobuf:"bytes,1,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
+	Username             string               `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	StripId              string               `protobuf:"bytes,3,opt,name=strip_id,json=stripId,proto3" json:"strip_id,omitempty"`
+	CreatedAt            *timestamp.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Rows                 int32                `protobuf:"varint,5,opt,name=rows,proto3" json:"rows,omitempty"`
+	Cols                 int32                `protobuf:"varint,6,opt,name=cols,proto3" json:"cols,omitempty"`
+	Cells                []int64              `protobuf:"varint,7,rep,packed,name=cells,proto3" json:"cells,omitempty"`
+	Marked               []int64              `protobuf:"varint,8,rep,packed,name=marked,proto3" json:"marked,omitempty"`
+	AutoDaub             bool                 `protobuf:"varint,9,opt,name=auto_daub,json=autoDaub,proto3" json:"auto_daub,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ListTicketsReply_Listing) Reset()         { *m = ListTicketsReply_Listing{} }
+func (m *ListTicketsReply_Listing) String() string { return proto.CompactTextString(m) }
+func (*ListTicketsReply_Listing) ProtoMessage()    {}
+func (*ListTicketsReply_Listing) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3437672f241df7a6, []int{12, 0}
+}
+
+func (m *ListTicketsReply_Listing) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListTicketsReply_Listing.Unmarshal(m, b)
+}
+func (m *ListTicketsReply_Listing) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListTicketsReply_Listing.Marshal(b, m, deterministic)
+}
+func (m *ListTicketsReply_Listing) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListTicketsReply_Listing.Merge(m, src)
+}
+func (m *ListTicketsReply_Listing) XXX_Size() int {
+	return xxx_messageInfo_ListTicketsReply_Listing.Size(m)
+}
+func (m *ListTicketsReply_Listing) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListTicketsReply_Listing.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListTicketsReply_Listing proto.InternalMessageInfo
+
+func (m *ListTicketsReply_Listing) GetTicketId() string {
+	if m != nil {
+		return m.TicketId
+	}
+	return ""
+}
+
+func (m *ListTicketsReply_Listing) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *ListTicketsReply_Listing) GetStripId() string {
+	if m != nil {
+		return m.StripId
+	}
+	return ""
+}
+
+func (m *ListTicketsReply_Listing) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *ListTicketsReply_Listing) GetRows() int32 {
+	if m != nil {
+		return m.Rows
+	}
+	return 0
+}
+
+func (m *ListTicketsReply_Listing) GetCols() int32 {
+	if m != nil {
+		return m.Cols
+	}
+	return 0
+}
+
+func (m *ListTicketsReply_Listing) GetCells() []int64 {
+	if m != nil {
+		return m.Cells
+	}
+	return nil
+}
+
+func (m *ListTicketsReply_Listing) GetMarked() []int64 {
+	if m != nil {
+		return m.Marked
+	}
+	return nil
+}
+
+func (m *ListTicketsReply_Listing) GetAutoDaub() bool {
+	if m != nil {
+		return m.AutoDaub
+	}
+	return false
+}
+
+type MarkNumberRequest struct {
+	TicketId             string   `protobuf:"bytes,1,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
+	Number               int64    `protobuf:"varint,2,opt,name=number,proto3" json:"number,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MarkNumberRequest) Reset()         { *m = MarkNumberRequest{} }
+func (m *MarkNumberRequest) String() string { return proto.CompactTextString(m) }
+func (*MarkNumberRequest) ProtoMessage()    {}
+func (*MarkNumberRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3437672f241df7a6, []int{13}
+}
+
+func (m *MarkNumberRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MarkNumberRequest.Unmarshal(m, b)
+}
+func (m *MarkNumberRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MarkNumberRequest.Marshal(b, m, deterministic)
+}
+func (m *MarkNumberRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarkNumberRequest.Merge(m, src)
+}
+func (m *MarkNumberRequest) XXX_Size() int {
+	return xxx_messageInfo_MarkNumberRequest.Size(m)
+}
+func (m *MarkNumberRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarkNumberRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarkNumberRequest proto.InternalMessageInfo
+
+func (m *MarkNumberRequest) GetTicketId() string {
+	if m != nil {
+		return m.TicketId
+	}
+	return ""
+}
+
+func (m *MarkNumberRequest) GetNumber() int64 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+type MarkNumberReply struct {
+	Number               int64    `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	Marked               bool     `protobuf:"varint,2,opt,name=marked,proto3" json:"marked,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MarkNumberReply) Reset()         { *m = MarkNumberReply{} }
+func (m *MarkNumberReply) String() string { return proto.CompactTextString(m) }
+func (*MarkNumberReply) ProtoMessage()    {}
+func (*MarkNumberReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3437672f241df7a6, []int{14}
+}
+
+func (m *MarkNumberReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MarkNumberReply.Unmarshal(m, b)
+}
+func (m *MarkNumberReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MarkNumberReply.Marshal(b, m, deterministic)
+}
+func (m *MarkNumberReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarkNumberReply.Merge(m, src)
+}
+func (m *MarkNumberReply) XXX_Size() int {
+	return xxx_messageInfo_MarkNumberReply.Size(m)
+}
+func (m *MarkNumberReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarkNumberReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarkNumberReply proto.InternalMessageInfo
+
+func (m *MarkNumberReply) GetNumber() int64 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+func (m *MarkNumberReply) GetMarked() bool {
+	if m != nil {
+		return m.Marked
+	}
+	return false
+}
+
+type SetAutoDaubRequest struct {
+	TicketId             string   `protobuf:"bytes,1,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
+	Enabled              bool     `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetAutoDaubRequest) Reset()         { *m = SetAutoDaubRequest{} }
+func (m *SetAutoDaubRequest) String() string { return proto.CompactTextString(m) }
+func (*SetAutoDaubRequest) ProtoMessage()    {}
+func (*SetAutoDaubRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3437672f241df7a6, []int{15}
+}
+
+func (m *SetAutoDaubRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetAutoDaubRequest.Unmarshal(m, b)
+}
+func (m *SetAutoDaubRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetAutoDaubRequest.Marshal(b, m, deterministic)
+}
+func (m *SetAutoDaubRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetAutoDaubRequest.Merge(m, src)
+}
+func (m *SetAutoDaubRequest) XXX_Size() int {
+	return xxx_messageInfo_SetAutoDaubRequest.Size(m)
+}
+func (m *SetAutoDaubRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetAutoDaubRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetAutoDaubRequest proto.InternalMessageInfo
+
+func (m *SetAutoDaubRequest) GetTicketId() string {
+	if m != nil {
+		return m.TicketId
+	}
+	return ""
+}
+
+func (m *SetAutoDaubRequest) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+type SetAutoDaubReply struct {
+	Enabled              bool     `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetAutoDaubReply) Reset()         { *m = SetAutoDaubReply{} }
+func (m *SetAutoDaubReply) String() string { return proto.CompactTextString(m) }
+func (*SetAutoDaubReply) ProtoMessage()    {}
+func (*SetAutoDaubReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3437672f241df7a6, []int{16}
+}
+
+func (m *SetAutoDaubReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetAutoDaubReply.Unmarshal(m, b)
+}
+func (m *SetAutoDaubReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetAutoDaubReply.Marshal(b, m, deterministic)
+}
+func (m *SetAutoDaubReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetAutoDaubReply.Merge(m, src)
+}
+func (m *SetAutoDaubReply) XXX_Size() int {
+	return xxx_messageInfo_SetAutoDaubReply.Size(m)
+}
+func (m *SetAutoDaubReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetAutoDaubReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetAutoDaubReply proto.InternalMessageInfo
+
+func (m *SetAutoDaubReply) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+type GenerateNumberRequest struct {
+	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// call names to announce the number with, those of the game when empty
+	Locale               string   `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GenerateNumberRequest) Reset()         { *m = GenerateNumberRequest{} }
+func (m *GenerateNumberRequest) String() string { return proto.CompactTextString(m) }
+func (*GenerateNumberRequest) ProtoMessage()    {}
+func (*GenerateNumberRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3437672f241df7a6, []int{17}
+}
+
+func (m *GenerateNumberRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GenerateNumberRequest.Unmarshal(m, b)
+}
+func (m *GenerateNumberRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GenerateNumberRequest.Marshal(b, m, deterministic)
+}
+func (m *GenerateNumberRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenerateNumberRequest.Merge(m, src)
+}
+func (m *GenerateNumberRequest) XXX_Size() int {
+	return xxx_messageInfo_GenerateNumberRequest.Size(m)
+}
+func (m *GenerateNumberRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenerateNumberRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenerateNumberRequest proto.InternalMessageInfo
+
+func (m *GenerateNumberRequest) GetGameId() string {
+	if m != nil {
+		return m.GameId
+	}
+	return ""
+}
+
+func (m *GenerateNumberRequest) GetLocale() string {
+	if m != nil {
+		return m.Locale
+	}
+	return ""
+}
+
+type Draw struct {
+	Seq                  int32                `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
+	Number               int64                `protobuf:"varint,2,opt,name=number,proto3" json:"number,omitempty"`
+	DrawnAt              *timestamp.Timestamp `protobuf:"bytes,3,opt,name=drawn_at,json=drawnAt,proto3" json:"drawn_at,omitempty"`
+	Caller               string               `protobuf:"bytes,4,opt,name=caller,proto3" json:"caller,omitempty"`
+	Announcement         string               `protobuf:"bytes,5,opt,name=announcement,proto3" json:"announcement,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *Draw) Reset()         { *m = Draw{} }
+func (m *Draw) String() string { return proto.CompactTextString(m) }
+func (*Draw) ProtoMessage()    {}
+func (*Draw) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3437672f241df7a6, []int{18}
+}
+
+func (m *Draw) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Draw.Unmarshal(m, b)
+}
+func (m *Draw) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Draw.Marshal(b, m, deterministic)
+}
+func (m *Draw) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Draw.Merge(m, src)
+}
+func (m *Draw) XXX_Size() int {
+	return xxx_messageInfo_Draw.Size(m)
+}
+func (m *Draw) XXX_DiscardUnknown() {
+	xxx_messageInfo_Draw.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Draw proto.InternalMessageInfo
+
+func (m *Draw) GetSeq() int32 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+func (m *Draw) GetNumber() int64 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+func (m *Draw) GetDrawnAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.DrawnAt
+	}
+	return nil
+}
+
+func (m *Draw) GetCaller() string {
+	if m != nil {
+		return m.Caller
+	}
+	return ""
+}
+
+func (m *Draw) GetAnnouncement() string {
+	if m != nil {
+		return m.Announcement
+	}
+	return ""
+}
+
+type GetDrawsRequest struct {
+	GameId               string   `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Since                int32    `protobuf:"varint,2,opt,name=since,proto3" json:"since,omitempty"`
+	Locale               string   `protobuf:"bytes,3,opt,name=locale,proto3" json:"locale,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetDrawsRequest) Reset()         { *m = GetDrawsRequest{} }
+func (m *GetDrawsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetDrawsRequest) ProtoMessage()    {}
+func (*GetDrawsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3437672f241df7a6, []int{19}
+}
+
+func (m *GetDrawsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDrawsRequest.Unmarshal(m, b)
+}
+func (m *GetDrawsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDrawsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetDrawsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDrawsRequest.Merge(m, src)
+}
+func (m *GetDrawsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetDrawsRequest.Size(m)
+}
+func (m *GetDrawsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDrawsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDrawsRequest proto.InternalMessageInfo
+
+func (m *GetDrawsRequest) GetGameId() string {
+	if m != nil {
+		return m.GameId
+	}
+	return ""
+}
+
+func (m *GetDrawsRequest) GetSince() int32 {
+	if m != nil {
+		return m.Since
+	}
+	return 0
+}
+
+func (m *GetDrawsRequest) GetLocale() string {
+	if m != nil {
+		return m.Locale
+	}
+	return ""
+}
+
+type GetDrawsReply struct {
+	Draws                []*Draw  `protobuf:"bytes,1,rep,name=draws,proto3" json:"draws,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetDrawsReply) Reset()         { *m = GetDrawsReply{} }
+func (m *GetDrawsReply) String() string { return proto.CompactTextString(m) }
+func (*GetDrawsReply) ProtoMessage()    {}
+func (*GetDrawsReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3437672f241df7a6, []int{20}
+}
+
+func (m *GetDrawsReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDrawsReply.Unmarshal(m, b)
+}
+func (m *GetDrawsReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDrawsReply.Marshal(b, m, deterministic)
+}
+func (m *GetDrawsReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDrawsReply.Merge(m, src)
+}
+func (m *GetDrawsReply) XXX_Size() int {
+	return xxx_messageInfo_GetDrawsReply.Size(m)
+}
+func (m *GetDrawsReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDrawsReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDrawsReply proto.InternalMessageInfo
+
+func (m *GetDrawsReply) GetDraws() []*Draw {
+	if m != nil {
+		return m.Draws
+	}
+	return nil
+}
+
+type SetCallsRequest struct {
+	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Locale string `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale,omitempty"`
+	// custom call names by number, for locale "custom"
+	Names                map[int64]string `protobuf:"bytes,3,rep,name=names,proto3" json:"names,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *SetCallsRequest) Reset()         { *m = SetCallsRequest{} }
+func (m *SetCallsRequest) String() string { return proto.CompactTextString(m) }
+func (*SetCallsRequest) ProtoMessage()    {}
+func (*SetCallsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3437672f241df7a6, []int{21}
+}
+
+func (m *SetCallsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetCallsRequest.Unmarshal(m, b)
+}
+func (m *SetCallsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetCallsRequest.Marshal(b, m, deterministic)
+}
+func (m *SetCallsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetCallsRequest.Merge(m, src)
+}
+func (m *SetCallsRequest) XXX_Size() int {
+	return xxx_messageInfo_SetCallsRequest.Size(m)
+}
+func (m *SetCallsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetCallsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetCallsRequest proto.InternalMessageInfo
+
+func (m *SetCallsRequest) GetGameId() string {
+	if m != nil {
+		return m.GameId
+	}
+	return ""
+}
+
+func (m *SetCallsRequest) GetLocale() string {
+	if m != nil {
+		return m.Locale
+	}
+	return ""
+}
+
+func (m *SetCallsRequest) GetNames() map[int64]string {
+	if m != nil {
+		return m.Names
+	}
+	return nil
+}
+
+type SetCallsReply struct {
+	Locale               string   `protobuf:"bytes,1,opt,name=locale,proto3" json:"locale,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetCallsReply) Reset()         { *m = SetCallsReply{} }
+func (m *SetCallsReply) String() string { return proto.CompactTextString(m) }
+func (*SetCallsReply) ProtoMessage()    {}
+func (*SetCallsReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3437672f241df7a6, []int{22}
+}
+
+func (m *SetCallsReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetCallsReply.Unmarshal(m, b)
+}
+func (m *SetCallsReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetCallsReply.Marshal(b, m, deterministic)
+}
+func (m *SetCallsReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetCallsReply.Merge(m, src)
+}
+func (m *SetCallsReply) XXX_Size() int {
+	return xxx_messageInfo_SetCallsReply.Size(m)
+}
+func (m *SetCallsReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetCallsReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetCallsReply proto.InternalMessageInfo
+
+func (m *SetCallsReply) GetLocale() string {
+	if m != nil {
+		return m.Locale
+	}
+	return ""
+}
+
+type Stats struct {
+	NumbersDrawn         int32                `protobuf:"varint,1,opt,name=numbers_drawn,json=numbersDrawn,proto3" json:"numbers_drawn,omitempty"`
+	NumbersRemaining     int32                `protobuf:"varint,2,opt,name=numbers_remaining,json=numbersRemaining,proto3" json:"numbers_remaining,omitempty"`
+	TicketsGenerated     int32                `protobuf:"varint,3,opt,name=tickets_generated,json=ticketsGenerated,proto3" json:"tickets_generated,omitempty"`
+	Players              int32                `protobuf:"varint,4,opt,name=players,proto3" json:"players,omitempty"`
+	FirstDrawnAt         *timestamp.Timestamp `protobuf:"bytes,5,opt,name=first_drawn_at,json=firstDrawnAt,proto3" json:"first_drawn_at,omitempty"`
+	LastDrawnAt          *timestamp.Timestamp `protobuf:"bytes,6,opt,name=last_drawn_at,json=lastDrawnAt,proto3" json:"last_drawn_at,omitempty"`
+	DrawsPerMinute       float64              `protobuf:"fixed64,7,opt,name=draws_per_minute,json=drawsPerMinute,proto3" json:"draws_per_minute,omitempty"`
+	Prizes               []*Stats_PrizeStatus `protobuf:"bytes,8,rep,name=prizes,proto3" json:"prizes,omitempty"`
+	Leaderboard          []*Stats_Standing    `protobuf:"bytes,9,rep,name=leaderboard,proto3" json:"leaderboard,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *Stats) Reset()         { *m = Stats{} }
+func (m *Stats) String() string { return proto.CompactTextString(m) }
+func (*Stats) ProtoMessage()    {}
+func (*Stats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3437672f241df7a6, []int{23}
+}
+
+func (m *Stats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Stats.Unmarshal(m, b)
+}
+func (m *Stats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Stats.Marshal(b, m, deterministic)
+}
+func (m *Stats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Stats.Merge(m, src)
+}
+func (m *Stats) XXX_Size() int {
+	return xxx_messageInfo_Stats.Size(m)
+}
+func (m *Stats) XXX_DiscardUnknown() {
+	xxx_messageInfo_Stats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Stats proto.InternalMessageInfo
+
+func (m *Stats) GetNumbersDrawn() int32 {
+	if m != nil {
+		return m.NumbersDrawn
+	}
+	return 0
+}
+
+func (m *Stats) GetNumbersRemaining() int32 {
+	if m != nil {
+		return m.NumbersRemaining
+	}
+	return 0
+}
+
+func (m *Stats) GetTicketsGenerated() int32 {
+	if m != nil {
+		return m.TicketsGenerated
+	}
+	return 0
+}
+
+func (m *Stats) GetPlayers() int32 {
+	if m != nil {
+		return m.Players
+	}
+	return 0
+}
+
+func (m *Stats) GetFirstDrawnAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.FirstDrawnAt
+	}
+	return nil
+}
+
+func (m *Stats) GetLastDrawnAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.LastDrawnAt
+	}
+	return nil
+}
+
+func (m *Stats) GetDrawsPerMinute() float64 {
+	if m != nil {
+		return m.DrawsPerMinute
+	}
+	return 0
+}
+
+func (m *Stats) GetPrizes() []*Stats_PrizeStatus {
+	if m != nil {
+		return m.Prizes
+	}
+	return nil
+}
+
+func (m *Stats) GetLeaderboard() []*Stats_Standing {
+	if m != nil {
+		return m.Leaderboard
+	}
+	return nil
+}
+
+type Stats_PrizeStatus struct {
+	Prize                string   `protobuf:"bytes,1,opt,name=prize,proto3" json:"prize,omitempty"`
+	Claimed              bool     `protobuf:"varint,2,opt,name=claimed,proto3" json:"claimed,omitempty"`
+	TicketId             string   `protobuf:"bytes,3,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Stats_PrizeStatus) Reset()         { *m = Stats_PrizeStatus{} }
+func (m *Stats_PrizeStatus) String() string { return proto.CompactTextString(m) }
+func (*Stats_PrizeStatus) ProtoMessage()    {}
+func (*Stats_PrizeStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3437672f241df7a6, []int{23, 0}
+}
+
+func (m *Stats_PrizeStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Stats_PrizeStatus.Unmarshal(m, b)
+}
+func (m *Stats_PrizeStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Stats_PrizeStatus.Marshal(b, m, deterministic)
+}
+func (m *Stats_PrizeStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Stats_PrizeStatus.Merge(m, src)
+}
+func (m *Stats_PrizeStatus) XXX_Size() int {
+	return xxx_messageInfo_Stats_PrizeStatus.Size(m)
+}
+func (m *Stats_PrizeStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_Stats_PrizeStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Stats_PrizeStatus proto.InternalMessageInfo
+
+func (m *Stats_PrizeStatus) GetPrize() string {
+	if m != nil {
+		return m.Prize
+	}
+	return ""
+}
+
+func (m *Stats_PrizeStatus) GetClaimed() bool {
+	if m != nil {
+		return m.Claimed
+	}
+	return false
+}
+
+func (m *Stats_PrizeStatus) GetTicketId() string {
+	if m != nil {
+		return m.TicketId
+	}
+	return ""
+}
+
+type Stats_Standing struct {
+	TicketId             string   `protobuf:"bytes,1,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
+	Username             string   `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	FullHouseAway        int32    `protobuf:"varint,3,opt,name=full_house_away,json=fullHouseAway,proto3" json:"full_house_away,omitempty"`
+	LineAway             int32    `protobuf:"varint,4,opt,name=line_away,json=lineAway,proto3" json:"line_away,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Stats_Standing) Reset()         { *m = Stats_Standing{} }
+func (m *Stats_Standing) String() string { return proto.CompactTextString(m) }
+func (*Stats_Standing) ProtoMessage()    {}
+func (*Stats_Standing) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3437672f241df7a6, []int{23, 1}
+}
+
+func (m *Stats_Standing) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Stats_Standing.Unmarshal(m, b)
+}
+func (m *Stats_Standing) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Stats_Standing.Marshal(b, m, deterministic)
+}
+func (m *Stats_Standing) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Stats_Standing.Merge(m, src)
+}
+func (m *Stats_Standing) XXX_Size() int {
+	return xxx_messageInfo_Stats_Standing.Size(m)
+}
+func (m *Stats_Standing) XXX_DiscardUnknown() {
+	xxx_messageInfo_Stats_Standing.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Stats_Standing proto.InternalMessageInfo
+
+func (m *Stats_Standing) GetTicketId() string {
+	if m != nil {
+		return m.TicketId
+	}
+	return ""
+}
+
+func (m *Stats_Standing) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *Stats_Standing) GetFullHouseAway() int32 {
+	if m != nil {
+		return m.FullHouseAway
+	}
+	return 0
+}
+
+func (m *Stats_Standing) GetLineAway() int32 {
+	if m != nil {
+		return m.LineAway
+	}
+	return 0
+}
+
+type ClaimPrizeRequest struct {
+	GameId               string   `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	TicketId             string   `protobuf:"bytes,2,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
+	Prize                string   `protobuf:"bytes,3,opt,name=prize,proto3" json:"prize,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ClaimPrizeRequest) Reset()         { *m = ClaimPrizeRequest{} }
+func (m *ClaimPrizeRequest) String() string { return proto.CompactTextString(m) }
+func (*ClaimPrizeRequest) ProtoMessage()    {}
+func (*ClaimPrizeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3437672f241df7a6, []int{24}
+}
+
+func (m *ClaimPrizeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ClaimPrizeRequest.Unmarshal(m, b)
+}
+func (m *ClaimPrizeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ClaimPrizeRequest.Marshal(b, m, deterministic)
+}
+func (m *ClaimPrizeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClaimPrizeRequest.Merge(m, src)
+}
+func (m *ClaimPrizeRequest) XXX_Size() int {
+	return xxx_messageInfo_ClaimPrizeRequest.Size(m)
+}
+func (m *ClaimPrizeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClaimPrizeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClaimPrizeRequest proto.InternalMessageInfo
+
+func (m *ClaimPrizeRequest) GetGameId() string {
+	if m != nil {
+		return m.GameId
+	}
+	return ""
+}
+
+func (m *ClaimPrizeRequest) GetTicketId() string {
+	if m != nil {
+		return m.TicketId
+	}
+	return ""
+}
+
+func (m *ClaimPrizeRequest) GetPrize() string {
+	if m != nil {
+		return m.Prize
+	}
+	return ""
+}
+
+type ClaimPrizeReply struct {
+	Prize                string   `protobuf:"bytes,1,opt,name=prize,proto3" json:"prize,omitempty"`
+	TicketId             string   `protobuf:"bytes,2,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ClaimPrizeReply) Reset()         { *m = ClaimPrizeReply{} }
+func (m *ClaimPrizeReply) String() string { return proto.CompactTextString(m) }
+func (*ClaimPrizeReply) ProtoMessage()    {}
+func (*ClaimPrizeReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3437672f241df7a6, []int{25}
+}
+
+func (m *ClaimPrizeReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ClaimPrizeReply.Unmarshal(m, b)
+}
+func (m *ClaimPrizeReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ClaimPrizeReply.Marshal(b, m, deterministic)
+}
+func (m *ClaimPrizeReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClaimPrizeReply.Merge(m, src)
+}
+func (m *ClaimPrizeReply) XXX_Size() int {
+	return xxx_messageInfo_ClaimPrizeReply.Size(m)
+}
+func (m *ClaimPrizeReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClaimPrizeReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClaimPrizeReply proto.InternalMessageInfo
+
+func (m *ClaimPrizeReply) GetPrize() string {
+	if m != nil {
+		return m.Prize
+	}
+	return ""
+}
+
+func (m *ClaimPrizeReply) GetTicketId() string {
+	if m != nil {
+		return m.TicketId
+	}
+	return ""
+}
+
+type WatchGameRequest struct {
+	GameId               string   `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	LastEventId          int64    `protobuf:"varint,2,opt,name=last_event_id,json=lastEventId,proto3" json:"last_event_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WatchGameRequest) Reset()         { *m = WatchGameRequest{} }
+func (m *WatchGameRequest) String() string { return proto.CompactTextString(m) }
+func (*WatchGameRequest) ProtoMessage()    {}
+func (*WatchGameRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3437672f241df7a6, []int{26}
+}
+
+func (m *WatchGameRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WatchGameRequest.Unmarshal(m, b)
+}
+func (m *WatchGameRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WatchGameRequest.Marshal(b, m, deterministic)
+}
+func (m *WatchGameRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchGameRequest.Merge(m, src)
+}
+func (m *WatchGameRequest) XXX_Size() int {
+	return xxx_messageInfo_WatchGameRequest.Size(m)
+}
+func (m *WatchGameRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchGameRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchGameRequest proto.InternalMessageInfo
+
+func (m *WatchGameRequest) GetGameId() string {
+	if m != nil {
+		return m.GameId
+	}
+	return ""
+}
+
+func (m *WatchGameRequest) GetLastEventId() int64 {
+	if m != nil {
+		return m.LastEventId
+	}
+	return 0
+}
+
+// Event is what happened in a game, number is set for number_drawn events and ticket_id for ticket_created events.
+// Only number_drawn events have an id, the sequence of the number, to resume watching from.
+type Event struct {
+	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type                 string   `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	GameId               string   `protobuf:"bytes,3,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Number               int64    `protobuf:"varint,4,opt,name=number,proto3" json:"number,omitempty"`
+	TicketId             string   `protobuf:"bytes,5,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Event) Reset()         { *m = Event{} }
+func (m *Event) String() string { return proto.CompactTextString(m) }
+func (*Event) ProtoMessage()    {}
+func (*Event) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3437672f241df7a6, []int{27}
+}
+
+func (m *Event) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Event.Unmarshal(m, b)
+}
+func (m *Event) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Event.Marshal(b, m, deterministic)
+}
+func (m *Event) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Event.Merge(m, src)
+}
+func (m *Event) XXX_Size() int {
+	return xxx_messageInfo_Event.Size(m)
+}
+func (m *Event) XXX_DiscardUnknown() {
+	xxx_messageInfo_Event.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Event proto.InternalMessageInfo
+
+func (m *Event) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Event) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *Event) GetGameId() string {
+	if m != nil {
+		return m.GameId
+	}
+	return ""
+}
+
+func (m *Event) GetNumber() int64 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+func (m *Event) GetTicketId() string {
+	if m != nil {
+		return m.TicketId
+	}
+	return ""
+}
+
+type StatusReply struct {
+	Status               string   `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StatusReply) Reset()         { *m = StatusReply{} }
+func (m *StatusReply) String() string { return proto.CompactTextString(m) }
+func (*StatusReply) ProtoMessage()    {}
+func (*StatusReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3437672f241df7a6, []int{28}
+}
+
+func (m *StatusReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StatusReply.Unmarshal(m, b)
+}
+func (m *StatusReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StatusReply.Marshal(b, m, deterministic)
+}
+func (m *StatusReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatusReply.Merge(m, src)
+}
+func (m *StatusReply) XXX_Size() int {
+	return xxx_messageInfo_StatusReply.Size(m)
+}
+func (m *StatusReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatusReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StatusReply proto.InternalMessageInfo
+
+func (m *StatusReply) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+type GetSeedReply struct {
+	SeedHash             string   `protobuf:"bytes,1,opt,name=seed_hash,json=seedHash,proto3" json:"seed_hash,omitempty"`
+	Seed                 string   `protobuf:"bytes,2,opt,name=seed,proto3" json:"seed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetSeedReply) Reset()         { *m = GetSeedReply{} }
+func (m *GetSeedReply) String() string { return proto.CompactTextString(m) }
+func (*GetSeedReply) ProtoMessage()    {}
+func (*GetSeedReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3437672f241df7a6, []int{29}
+}
+
+func (m *GetSeedReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetSeedReply.Unmarshal(m, b)
+}
+func (m *GetSeedReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetSeedReply.Marshal(b, m, deterministic)
+}
+func (m *GetSeedReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSeedReply.Merge(m, src)
+}
+func (m *GetSeedReply) XXX_Size() int {
+	return xxx_messageInfo_GetSeedReply.Size(m)
+}
+func (m *GetSeedReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSeedReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSeedReply proto.InternalMessageInfo
+
+func (m *GetSeedReply) GetSeedHash() string {
+	if m != nil {
+		return m.SeedHash
+	}
+	return ""
+}
+
+func (m *GetSeedReply) GetSeed() string {
+	if m != nil {
+		return m.Seed
+	}
+	return ""
+}
+
+type StartAutoCallRequest struct {
+	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// 5s when not set
+	Interval             *duration.Duration `protobuf:"bytes,2,opt,name=interval,proto3" json:"interval,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *StartAutoCallRequest) Reset()         { *m = StartAutoCallRequest{} }
+func (m *StartAutoCallRequest) String() string { return proto.CompactTextString(m) }
+func (*StartAutoCallRequest) ProtoMessage()    {}
+func (*StartAutoCallRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3437672f241df7a6, []int{30}
+}
+
+func (m *StartAutoCallRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StartAutoCallRequest.Unmarshal(m, b)
+}
+func (m *StartAutoCallRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StartAutoCallRequest.Marshal(b, m, deterministic)
+}
+func (m *StartAutoCallRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StartAutoCallRequest.Merge(m, src)
+}
+func (m *StartAutoCallRequest) XXX_Size() int {
+	return xxx_messageInfo_StartAutoCallRequest.Size(m)
+}
+func (m *StartAutoCallRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StartAutoCallRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StartAutoCallRequest proto.InternalMessageInfo
+
+func (m *StartAutoCallRequest) GetGameId() string {
+	if m != nil {
+		return m.GameId
+	}
+	return ""
+}
+
+func (m *StartAutoCallRequest) GetInterval() *duration.Duration {
+	if m != nil {
+		return m.Interval
+	}
+	return nil
+}
+
+type AutoCallReply struct {
+	Enabled              bool               `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Interval             *duration.Duration `protobuf:"bytes,2,opt,name=interval,proto3" json:"interval,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *AutoCallReply) Reset()         { *m = AutoCallReply{} }
+func (m *AutoCallReply) String() string { return proto.CompactTextString(m) }
+func (*AutoCallReply) ProtoMessage()    {}
+func (*AutoCallReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3437672f241df7a6, []int{31}
+}
+
+func (m *AutoCallReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AutoCallReply.Unmarshal(m, b)
+}
+func (m *AutoCallReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AutoCallReply.Marshal(b, m, deterministic)
+}
+func (m *AutoCallReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoCallReply.Merge(m, src)
+}
+func (m *AutoCallReply) XXX_Size() int {
+	return xxx_messageInfo_AutoCallReply.Size(m)
+}
+func (m *AutoCallReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoCallReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoCallReply proto.InternalMessageInfo
+
+func (m *AutoCallReply) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *AutoCallReply) GetInterval() *duration.Duration {
+	if m != nil {
+		return m.Interval
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*GameRequest)(nil), "pb.GameRequest")
+	proto.RegisterType((*CreateGameRequest)(nil), "pb.CreateGameRequest")
+	proto.RegisterType((*CreateGameReply)(nil), "pb.CreateGameReply")
+	proto.RegisterType((*AuthorizeHostRequest)(nil), "pb.AuthorizeHostRequest")
+	proto.RegisterType((*AuthorizeHostReply)(nil), "pb.AuthorizeHostReply")
+	proto.RegisterType((*CreateTicketRequest)(nil), "pb.CreateTicketRequest")
+	proto.RegisterType((*CreateTicketReply)(nil), "pb.CreateTicketReply")
+	proto.RegisterType((*CreateTicketStripReply)(nil), "pb.CreateTicketStripReply")
+	proto.RegisterType((*ShowTicketRequest)(nil), "pb.ShowTicketRequest")
+	proto.RegisterType((*Ticket)(nil), "pb.Ticket")
+	proto.RegisterType((*Format)(nil), "pb.Format")
+	proto.RegisterType((*ListTicketsRequest)(nil), "pb.ListTicketsRequest")
+	proto.RegisterType((*ListTicketsReply)(nil), "pb.ListTicketsReply")
+	proto.RegisterType((*ListTicketsReply_Listing)(nil), "pb.ListTicketsReply.Listing")
+	proto.RegisterType((*MarkNumberRequest)(nil), "pb.MarkNumberRequest")
+	proto.RegisterType((*MarkNumberReply)(nil), "pb.MarkNumberReply")
+	proto.RegisterType((*SetAutoDaubRequest)(nil), "pb.SetAutoDaubRequest")
+	proto.RegisterType((*SetAutoDaubReply)(nil), "pb.SetAutoDaubReply")
+	proto.RegisterType((*GenerateNumberRequest)(nil), "pb.GenerateNumberRequest")
+	proto.RegisterType((*Draw)(nil), "pb.Draw")
+	proto.RegisterType((*GetDrawsRequest)(nil), "pb.GetDrawsRequest")
+	proto.RegisterType((*GetDrawsReply)(nil), "pb.GetDrawsReply")
+	proto.RegisterType((*SetCallsRequest)(nil), "pb.SetCallsRequest")
+	proto.RegisterMapType((map[int64]string)(nil), "pb.SetCallsRequest.NamesEntry")
+	proto.RegisterType((*SetCallsReply)(nil), "pb.SetCallsReply")
+	proto.RegisterType((*Stats)(nil), "pb.Stats")
+	proto.RegisterType((*Stats_PrizeStatus)(nil), "pb.Stats.PrizeStatus")
+	proto.RegisterType((*Stats_Standing)(nil), "pb.Stats.Standing")
+	proto.RegisterType((*ClaimPrizeRequest)(nil), "pb.ClaimPrizeRequest")
+	proto.RegisterType((*ClaimPrizeReply)(nil), "pb.ClaimPrizeReply")
+	proto.RegisterType((*WatchGameRequest)(nil), "pb.WatchGameRequest")
+	proto.RegisterType((*Event)(nil), "pb.Event")
+	proto.RegisterType((*StatusReply)(nil), "pb.StatusReply")
+	proto.RegisterType((*GetSeedReply)(nil), "pb.GetSeedReply")
+	proto.RegisterType((*StartAutoCallRequest)(nil), "pb.StartAutoCallRequest")
+	proto.RegisterType((*AutoCallReply)(nil), "pb.AutoCallReply")
+}
+
+func init() { proto.RegisterFile("bingo.proto", fileDescriptor_3437672f241df7a6) }
+
+var fileDescriptor_3437672f241df7a6 = []byte{
+	// 1784 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x5b, 0x73, 0x23, 0x47,
+	0x15, 0xf6, 0x68, 0x74, 0x3d, 0x23, 0xaf, 0xad, 0xb6, 0xd6, 0x99, 0x1d, 0x60, 0x63, 0x9a, 0x22,
+	0xa8, 0x0a, 0x22, 0x2d, 0x66, 0x03, 0x21, 0x45, 0x20, 0x8e, 0xbd, 0x59, 0x6f, 0x42, 0x82, 0x6b,
+	0x94, 0x14, 0x54, 0x78, 0x10, 0x2d, 0x4d, 0x4b, 0x9a, 0xda, 0xb9, 0x28, 0xd3, 0x3d, 0xeb, 0x15,
+	0x8f, 0x3c, 0xa4, 0xa8, 0xe2, 0x0f, 0xf0, 0xce, 0x3f, 0xe0, 0x47, 0xc1, 0xdf, 0xa0, 0xfa, 0x32,
+	0x37, 0xc9, 0x6b, 0x19, 0x57, 0xe5, 0xad, 0xcf, 0x99, 0x73, 0xeb, 0xd3, 0x5f, 0x7f, 0x3a, 0x2d,
+	0xb0, 0xa6, 0x7e, 0xb4, 0x88, 0x87, 0xab, 0x24, 0xe6, 0x31, 0xaa, 0xad, 0xa6, 0xce, 0xe3, 0x45,
+	0x1c, 0x2f, 0x02, 0x3a, 0x92, 0x9a, 0x69, 0x3a, 0x1f, 0x79, 0x69, 0x42, 0xb8, 0x1f, 0x47, 0xca,
+	0xc6, 0x79, 0x7b, 0xf3, 0x3b, 0xf7, 0x43, 0xca, 0x38, 0x09, 0x57, 0xca, 0x00, 0xbf, 0x03, 0xd6,
+	0x73, 0x12, 0x52, 0x97, 0x7e, 0x93, 0x52, 0xc6, 0xd1, 0x5b, 0xd0, 0x5a, 0x90, 0x90, 0x4e, 0x7c,
+	0xcf, 0x36, 0x4e, 0x8c, 0x41, 0xc7, 0x6d, 0x0a, 0xf1, 0x85, 0x87, 0xbf, 0xad, 0x41, 0xef, 0x3c,
+	0xa1, 0x84, 0xd3, 0xb2, 0xf9, 0x31, 0x34, 0xe7, 0x71, 0x12, 0x12, 0x9e, 0x59, 0x2b, 0x09, 0x21,
+	0xa8, 0x27, 0xf1, 0x35, 0xb3, 0x6b, 0x27, 0xc6, 0xa0, 0xe1, 0xca, 0xb5, 0xd0, 0xcd, 0xe2, 0x80,
+	0xd9, 0xa6, 0xd2, 0x89, 0x35, 0x3a, 0x04, 0x33, 0xf4, 0x23, 0xbb, 0x7e, 0x62, 0x0c, 0x4c, 0x57,
+	0x2c, 0xa5, 0x86, 0xbc, 0xb6, 0x1b, 0x5a, 0x43, 0x5e, 0x23, 0x1b, 0x5a, 0x51, 0x1a, 0x4e, 0x69,
+	0xc2, 0xec, 0xa6, 0x74, 0xcd, 0x44, 0x34, 0x82, 0x7e, 0x48, 0x5e, 0x4f, 0xb8, 0x3f, 0x7b, 0x49,
+	0x39, 0x9b, 0xac, 0x68, 0x32, 0x49, 0x19, 0x4d, 0xec, 0x96, 0x34, 0xeb, 0x85, 0xe4, 0xf5, 0x97,
+	0xea, 0xd3, 0x15, 0x4d, 0xbe, 0x62, 0x34, 0x41, 0x6f, 0x83, 0x55, 0x72, 0xb0, 0xdb, 0xd2, 0x0e,
+	0x0a, 0x3b, 0xf4, 0x43, 0xe8, 0x32, 0x12, 0x50, 0x36, 0x99, 0xa5, 0x3c, 0x9e, 0xcf, 0xed, 0x8e,
+	0xdc, 0x95, 0x25, 0x75, 0xe7, 0x52, 0x85, 0x3f, 0x83, 0x83, 0x72, 0x1f, 0x56, 0xc1, 0xfa, 0x8d,
+	0x4d, 0x13, 0xf9, 0x96, 0x31, 0xe3, 0x13, 0x46, 0x67, 0x09, 0xe5, 0xb2, 0x1b, 0x1d, 0x17, 0x84,
+	0x6a, 0x2c, 0x35, 0xf8, 0x0a, 0xfa, 0x67, 0x29, 0x5f, 0xc6, 0x89, 0xff, 0x57, 0x7a, 0x19, 0x33,
+	0xbe, 0xeb, 0x18, 0x76, 0x47, 0xec, 0x03, 0xda, 0x88, 0xb8, 0x0a, 0xd6, 0xf8, 0x53, 0x38, 0x52,
+	0x45, 0xab, 0x8d, 0xee, 0x4c, 0xe3, 0x40, 0x5b, 0x74, 0x32, 0x22, 0x21, 0xd5, 0x39, 0x72, 0x19,
+	0x3f, 0x81, 0x5e, 0x35, 0x96, 0x68, 0xc1, 0xf7, 0xa0, 0xa3, 0xba, 0x5a, 0xc4, 0x6a, 0x2b, 0xc5,
+	0x0b, 0x0f, 0xbb, 0x70, 0x5c, 0xf6, 0x18, 0xf3, 0xc4, 0x5f, 0x29, 0xb7, 0x47, 0xd0, 0x66, 0x42,
+	0x2a, 0xbc, 0x5a, 0x52, 0x7e, 0xe1, 0xa1, 0x1f, 0x00, 0xe4, 0x11, 0x05, 0x90, 0xcc, 0x41, 0xc7,
+	0xed, 0x64, 0x21, 0x99, 0xa8, 0x62, 0xbc, 0x8c, 0xaf, 0xab, 0xfb, 0xb9, 0xb5, 0x8a, 0xff, 0xd4,
+	0xa0, 0xa9, 0xcc, 0xd1, 0x03, 0xa8, 0xe5, 0x06, 0x35, 0xdf, 0x2b, 0xf7, 0xa1, 0x56, 0xe9, 0x43,
+	0xb9, 0x3e, 0xb3, 0x5a, 0x5f, 0xb9, 0x45, 0xf5, 0x6a, 0x8b, 0x72, 0xf8, 0x37, 0x6e, 0x80, 0x7f,
+	0xb3, 0x04, 0xff, 0x3e, 0x34, 0x66, 0x34, 0x08, 0x98, 0xdd, 0x3a, 0x31, 0x07, 0xa6, 0xab, 0x04,
+	0x71, 0xa9, 0x42, 0x92, 0xbc, 0xa4, 0x9e, 0xdd, 0x96, 0x6a, 0x2d, 0x09, 0x6b, 0x2f, 0x21, 0xd7,
+	0x91, 0xdd, 0x51, 0xd6, 0x52, 0x10, 0x7b, 0x26, 0x29, 0x8f, 0x27, 0x1e, 0x49, 0xa7, 0x36, 0x9c,
+	0x18, 0x83, 0xb6, 0xdb, 0x16, 0x8a, 0x0b, 0x92, 0x4e, 0xd1, 0xaf, 0x01, 0x66, 0xb2, 0xf3, 0xde,
+	0x84, 0x70, 0xdb, 0x3a, 0x31, 0x06, 0xd6, 0xa9, 0x33, 0x54, 0x9c, 0x30, 0xcc, 0x38, 0x61, 0xf8,
+	0x65, 0xc6, 0x09, 0x6e, 0x47, 0x5b, 0x9f, 0x71, 0x84, 0xf3, 0xab, 0xdd, 0x95, 0x6e, 0x30, 0x5c,
+	0x4d, 0x87, 0x9f, 0x48, 0x4d, 0x7e, 0xcd, 0xfb, 0xd0, 0x88, 0xe2, 0x68, 0x46, 0xed, 0x7d, 0xd9,
+	0x00, 0x25, 0xe0, 0xbf, 0x19, 0xd0, 0xfc, 0x24, 0xe7, 0x01, 0xd9, 0x20, 0xd5, 0xea, 0x7a, 0xa5,
+	0x39, 0xdf, 0x29, 0x37, 0xe0, 0x3f, 0x03, 0xfa, 0xbd, 0xcf, 0xb8, 0xbe, 0xd8, 0x3b, 0x01, 0x7f,
+	0x0c, 0xcd, 0x78, 0x3e, 0x67, 0xfa, 0x4a, 0x35, 0x5c, 0x2d, 0x89, 0x1d, 0x06, 0x7e, 0xe8, 0x73,
+	0x5d, 0x99, 0x12, 0xf0, 0xb7, 0x26, 0x1c, 0x56, 0xa2, 0x0b, 0x2c, 0xff, 0x12, 0x5a, 0x19, 0xb1,
+	0x18, 0x27, 0xe6, 0xc0, 0x3a, 0xfd, 0xbe, 0xe8, 0xd8, 0xa6, 0x99, 0x54, 0xf8, 0xd1, 0xc2, 0xcd,
+	0x8c, 0x45, 0x0a, 0x1e, 0x73, 0x12, 0xe8, 0xcc, 0x4a, 0x28, 0x15, 0x64, 0x96, 0x0b, 0x72, 0xfe,
+	0x51, 0x83, 0x96, 0x0e, 0x71, 0x2b, 0xdc, 0x6f, 0xbb, 0xc2, 0xb7, 0xc1, 0xba, 0x8a, 0x98, 0xfa,
+	0xff, 0x83, 0x98, 0xef, 0x06, 0xf5, 0x15, 0x7c, 0x77, 0xaa, 0xf8, 0xc6, 0x97, 0xd0, 0xfb, 0x9c,
+	0x24, 0x2f, 0xbf, 0x90, 0x87, 0x7e, 0x17, 0x16, 0x10, 0x69, 0x14, 0x44, 0x64, 0x53, 0x4c, 0x57,
+	0x4b, 0xf8, 0x0c, 0x0e, 0xca, 0x91, 0xc4, 0x81, 0x16, 0xa6, 0x46, 0xd9, 0xb4, 0x54, 0x69, 0x4d,
+	0x96, 0xa3, 0x25, 0xfc, 0x19, 0xa0, 0x31, 0xe5, 0x67, 0xba, 0xb6, 0x3b, 0x55, 0x63, 0x43, 0x8b,
+	0x46, 0x64, 0x1a, 0xe4, 0xb1, 0x32, 0x11, 0xff, 0x0c, 0x0e, 0x2b, 0xc1, 0x44, 0x41, 0x25, 0x6b,
+	0xa3, 0x6a, 0x7d, 0x09, 0x0f, 0x9f, 0xd3, 0x88, 0x26, 0x84, 0xd3, 0x6a, 0x2f, 0x6e, 0x03, 0x7c,
+	0x10, 0xcf, 0x48, 0x90, 0x81, 0x43, 0x4b, 0xf8, 0x5f, 0x06, 0xd4, 0x2f, 0x12, 0x72, 0x2d, 0x2e,
+	0x1b, 0xa3, 0xdf, 0x48, 0xaf, 0x86, 0x2b, 0x96, 0x6f, 0x6a, 0x1d, 0x7a, 0x0f, 0xda, 0x92, 0x8a,
+	0x04, 0x60, 0xcc, 0x9d, 0x80, 0x69, 0x49, 0xdb, 0x33, 0x39, 0x3b, 0xcc, 0x48, 0x10, 0xd0, 0x44,
+	0xd3, 0xa7, 0x96, 0x10, 0x86, 0x2e, 0x89, 0xa2, 0x38, 0x8d, 0x66, 0x34, 0xa4, 0x11, 0x97, 0x70,
+	0xea, 0xb8, 0x15, 0x1d, 0xfe, 0x13, 0x1c, 0x3c, 0xa7, 0x5c, 0xd4, 0xb9, 0xfb, 0x6a, 0xf7, 0xa1,
+	0xc1, 0x7c, 0x41, 0x52, 0xfa, 0x7e, 0x49, 0xa1, 0xb4, 0x7f, 0xb3, 0xb2, 0xff, 0x11, 0xec, 0x17,
+	0x91, 0x45, 0xd3, 0x1f, 0x2b, 0xd6, 0xcd, 0x2e, 0x75, 0x5b, 0x5c, 0x6a, 0xf1, 0x59, 0xf1, 0x2f,
+	0xc3, 0xff, 0x36, 0xe0, 0x60, 0x4c, 0xf9, 0x39, 0x09, 0x02, 0x76, 0xdf, 0xae, 0xa3, 0xa7, 0xd0,
+	0x10, 0x17, 0x53, 0x10, 0xa0, 0x48, 0xf2, 0x58, 0x24, 0xd9, 0x08, 0x3a, 0xfc, 0x42, 0x18, 0x3c,
+	0x8b, 0x78, 0xb2, 0x76, 0x95, 0xb1, 0xf3, 0x3e, 0x40, 0xa1, 0x14, 0x07, 0xf6, 0x92, 0xae, 0x35,
+	0x56, 0xc5, 0x52, 0xec, 0xfc, 0x15, 0x09, 0xd2, 0x2c, 0x99, 0x12, 0x3e, 0xa8, 0xbd, 0x6f, 0xe0,
+	0x9f, 0xc0, 0x7e, 0x11, 0x5e, 0x63, 0x5d, 0x17, 0x66, 0x54, 0xda, 0xf1, 0xcf, 0x06, 0x34, 0xc6,
+	0x9c, 0x70, 0x86, 0x7e, 0x04, 0xfb, 0x9a, 0x5b, 0x27, 0xea, 0x57, 0x48, 0x21, 0xa3, 0xab, 0x95,
+	0xa2, 0x25, 0x11, 0xfa, 0x29, 0xf4, 0x32, 0xa3, 0x84, 0x86, 0xc4, 0x8f, 0xfc, 0x68, 0xa1, 0xfb,
+	0x7e, 0xa8, 0x3f, 0xb8, 0x99, 0x5e, 0x18, 0x67, 0xa3, 0xdb, 0x42, 0x83, 0xd7, 0xd3, 0x6c, 0x77,
+	0xa8, 0x3f, 0x64, 0xa0, 0x96, 0x37, 0x65, 0x15, 0x90, 0xb5, 0x60, 0xfa, 0xba, 0x62, 0x7a, 0x2d,
+	0xa2, 0x8f, 0xe0, 0xc1, 0xdc, 0x4f, 0x18, 0x9f, 0xe4, 0x20, 0x6c, 0xec, 0x04, 0x61, 0x57, 0x7a,
+	0x5c, 0x68, 0x24, 0xfe, 0x16, 0xf6, 0x03, 0x52, 0x0e, 0xd0, 0xdc, 0x19, 0xc0, 0x0a, 0x48, 0xe1,
+	0x3f, 0x80, 0x43, 0x89, 0x05, 0x39, 0x81, 0x86, 0x7e, 0x94, 0x72, 0x2a, 0x67, 0x50, 0xc3, 0x7d,
+	0x20, 0xf5, 0x57, 0x34, 0xf9, 0x5c, 0x6a, 0xd1, 0xbb, 0xd0, 0x5c, 0x89, 0xc9, 0x8c, 0x49, 0x92,
+	0xb3, 0x4e, 0x1f, 0xca, 0x83, 0x16, 0xfd, 0x1d, 0x5e, 0x09, 0xbd, 0x58, 0xa6, 0xcc, 0xd5, 0x46,
+	0xe8, 0x29, 0x58, 0x01, 0x25, 0x1e, 0x4d, 0xa6, 0x31, 0x49, 0x3c, 0xf9, 0xbb, 0x6f, 0x9d, 0xa2,
+	0xc2, 0x67, 0xcc, 0x49, 0xe4, 0x89, 0x1f, 0x93, 0xb2, 0x99, 0xf3, 0x35, 0x58, 0xa5, 0x60, 0x02,
+	0x05, 0x32, 0x9c, 0x3e, 0x59, 0x25, 0x88, 0x7e, 0xce, 0x02, 0xe2, 0x87, 0x05, 0xf3, 0x68, 0xb1,
+	0x4a, 0x58, 0x66, 0x95, 0xb0, 0x9c, 0xbf, 0x1b, 0xd0, 0xce, 0xb2, 0xde, 0xff, 0xf7, 0xe7, 0x1d,
+	0x38, 0x98, 0xa7, 0x41, 0x30, 0x59, 0xc6, 0x29, 0xa3, 0x13, 0x72, 0x4d, 0xd6, 0xfa, 0xdc, 0xf7,
+	0x85, 0xfa, 0x52, 0x68, 0xcf, 0xae, 0x89, 0x9c, 0x2a, 0x03, 0x3f, 0xd2, 0x16, 0xea, 0xd8, 0xdb,
+	0x42, 0x21, 0x3e, 0xe2, 0x09, 0xf4, 0xce, 0x45, 0xc9, 0x72, 0xaf, 0x3b, 0x6f, 0x5e, 0xa5, 0xd6,
+	0xda, 0x46, 0xad, 0x79, 0x8b, 0xcc, 0x52, 0x8b, 0xf0, 0x05, 0x1c, 0x94, 0x13, 0x88, 0x6b, 0x72,
+	0x73, 0x2f, 0x6f, 0x8b, 0x8d, 0xff, 0x00, 0x87, 0x7f, 0x24, 0x7c, 0xb6, 0xbc, 0xcb, 0x2b, 0x0b,
+	0x61, 0x8d, 0x44, 0xfa, 0x8a, 0x46, 0x79, 0x34, 0x53, 0xa1, 0xed, 0x99, 0xd0, 0xbd, 0xf0, 0xf0,
+	0x1a, 0x1a, 0x72, 0x59, 0x9a, 0x62, 0x4d, 0x39, 0xc5, 0x22, 0xa8, 0xf3, 0xf5, 0x2a, 0xeb, 0xb6,
+	0x5c, 0x97, 0x33, 0x99, 0x9b, 0x4c, 0xa4, 0xc9, 0xbc, 0x5e, 0x21, 0xf3, 0xca, 0x5e, 0x1a, 0x1b,
+	0x7b, 0xf9, 0x31, 0x58, 0x1a, 0xa1, 0x19, 0x69, 0x30, 0x29, 0x66, 0xbb, 0x50, 0x12, 0xfe, 0x1d,
+	0x74, 0x9f, 0x53, 0x3e, 0xa6, 0xd4, 0xcb, 0x1f, 0x07, 0x8c, 0x52, 0x6f, 0xb2, 0x24, 0x6c, 0x99,
+	0xe1, 0x44, 0x28, 0x2e, 0x09, 0x5b, 0x8a, 0xaa, 0xc5, 0x3a, 0xab, 0x5a, 0xac, 0xf1, 0x1c, 0xfa,
+	0x63, 0x4e, 0x12, 0xf9, 0xf3, 0x27, 0x48, 0x6a, 0x67, 0xdf, 0xde, 0x83, 0xb6, 0x1f, 0x71, 0x9a,
+	0xbc, 0xd2, 0x63, 0x94, 0x75, 0xfa, 0x68, 0xeb, 0xf2, 0x5e, 0xe8, 0x97, 0xb1, 0x9b, 0x9b, 0xe2,
+	0xbf, 0xc0, 0x7e, 0x91, 0xe2, 0xd6, 0x5f, 0xd8, 0x7b, 0x66, 0x38, 0xfd, 0x6f, 0x07, 0x1a, 0x1f,
+	0x8b, 0x37, 0x3b, 0xfa, 0x00, 0xa0, 0x78, 0x37, 0x22, 0x79, 0xf1, 0xb7, 0xde, 0xd3, 0xce, 0xd1,
+	0xa6, 0x5a, 0x3c, 0xde, 0xf6, 0xd0, 0x39, 0xec, 0x57, 0x1e, 0x75, 0xc8, 0x16, 0x76, 0x37, 0xbd,
+	0x1c, 0x9d, 0xe3, 0x1b, 0xbe, 0xa8, 0x20, 0x1f, 0x41, 0xb7, 0xfc, 0x0a, 0x43, 0x6f, 0x15, 0xb9,
+	0x2a, 0xaf, 0x28, 0xe7, 0xe1, 0xf6, 0x07, 0x15, 0xe1, 0x53, 0xe8, 0x6d, 0xbd, 0xe3, 0xde, 0x1c,
+	0xc6, 0xd9, 0xfc, 0x50, 0xbc, 0xfb, 0xf0, 0x1e, 0xfa, 0x39, 0x40, 0xf1, 0x7e, 0x53, 0xed, 0xd8,
+	0x7a, 0xcf, 0x39, 0xf2, 0xcd, 0xa1, 0x54, 0x78, 0x0f, 0x7d, 0x08, 0x56, 0x69, 0x9a, 0x46, 0xc7,
+	0x5b, 0xe3, 0xb5, 0x72, 0xea, 0xdf, 0x34, 0x76, 0xe3, 0x3d, 0x71, 0x00, 0xc5, 0x84, 0xa7, 0x32,
+	0x6e, 0xcd, 0x8e, 0xce, 0xd1, 0xa6, 0x5a, 0xf9, 0xfe, 0x06, 0xba, 0x5f, 0x45, 0xe1, 0x7d, 0xbd,
+	0x3f, 0x04, 0xab, 0x34, 0xcb, 0xa9, 0xc2, 0xb7, 0x27, 0x45, 0xa7, 0xbf, 0xa5, 0x57, 0xee, 0xbf,
+	0x82, 0x07, 0xd5, 0xe1, 0x0e, 0x3d, 0x12, 0x96, 0x37, 0x0e, 0x7c, 0x4e, 0x3e, 0x9f, 0xe0, 0x3d,
+	0xf4, 0x14, 0xda, 0xd9, 0x2c, 0x83, 0x8e, 0x94, 0x4b, 0x65, 0x66, 0x72, 0x7a, 0x55, 0xa5, 0x4a,
+	0xf7, 0x14, 0xda, 0xd9, 0x6c, 0xa0, 0xbc, 0x36, 0x06, 0x11, 0xa7, 0x57, 0x55, 0x2a, 0xaf, 0x81,
+	0xcc, 0xa5, 0x46, 0x85, 0x03, 0x19, 0xb6, 0x04, 0xeb, 0x4e, 0xfe, 0x93, 0xa5, 0xce, 0xa1, 0xa0,
+	0x55, 0x7d, 0x11, 0x36, 0x79, 0xdc, 0x39, 0xda, 0x54, 0xab, 0x2c, 0x4f, 0xa0, 0x93, 0x93, 0x29,
+	0x92, 0xfd, 0xda, 0xe4, 0x56, 0x95, 0x4b, 0x12, 0x24, 0xde, 0x7b, 0x62, 0xa0, 0x11, 0x74, 0x24,
+	0x95, 0x48, 0x8f, 0xad, 0xc2, 0x0e, 0xb2, 0xc2, 0xd2, 0x7c, 0x23, 0x23, 0xe8, 0x5c, 0x91, 0x94,
+	0xd1, 0x3b, 0x3b, 0x3c, 0x01, 0x70, 0x29, 0x4b, 0xc3, 0xbb, 0x7b, 0xbc, 0x0b, 0xad, 0x67, 0x91,
+	0x77, 0x67, 0xf3, 0x21, 0xb4, 0x34, 0x9d, 0x6e, 0x9b, 0x1f, 0xea, 0x13, 0xcc, 0xc9, 0x16, 0xef,
+	0x89, 0x71, 0xa6, 0xc2, 0x9e, 0x8a, 0x2d, 0x6e, 0x22, 0x54, 0x75, 0x94, 0x15, 0x0a, 0x94, 0x00,
+	0xe8, 0x8e, 0x79, 0xbc, 0xca, 0xdd, 0xb7, 0x92, 0xde, 0xe4, 0xf5, 0x31, 0xfe, 0xfa, 0x64, 0xe1,
+	0xf3, 0x65, 0x3a, 0x1d, 0xce, 0xe2, 0x70, 0xe4, 0xf9, 0xaf, 0xd6, 0x24, 0x62, 0xcb, 0x05, 0x99,
+	0x92, 0x91, 0xfc, 0xd3, 0x72, 0xb4, 0x9a, 0x4e, 0x9b, 0x92, 0x2a, 0x7f, 0xf1, 0xbf, 0x01, 0x00,
+	0x95, 0x34, 0xf2, 0xe1, 0xc7, 0x14, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// BingoClient is the client API for Bingo service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type BingoClient interface {
+	// CreateGame creates a game hosted by the authenticated player, or only with its host secret when called anonymously.
+	CreateGame(ctx context.Context, in *CreateGameRequest, opts ...grpc.CallOption) (*CreateGameReply, error)
+	// AuthorizeHost checks the host secret of a game.
+	AuthorizeHost(ctx context.Context, in *AuthorizeHostRequest, opts ...grpc.CallOption) (*AuthorizeHostReply, error)
+	CreateTicket(ctx context.Context, in *CreateTicketRequest, opts ...grpc.CallOption) (*CreateTicketReply, error)
+	CreateTicketStrip(ctx context.Context, in *CreateTicketRequest, opts ...grpc.CallOption) (*CreateTicketStripReply, error)
+	ShowTicket(ctx context.Context, in *ShowTicketRequest, opts ...grpc.CallOption) (*Ticket, error)
+	ListTickets(ctx context.Context, in *ListTicketsRequest, opts ...grpc.CallOption) (*ListTicketsReply, error)
+	MarkNumber(ctx context.Context, in *MarkNumberRequest, opts ...grpc.CallOption) (*MarkNumberReply, error)
+	UnmarkNumber(ctx context.Context, in *MarkNumberRequest, opts ...grpc.CallOption) (*MarkNumberReply, error)
+	SetAutoDaub(ctx context.Context, in *SetAutoDaubRequest, opts ...grpc.CallOption) (*SetAutoDaubReply, error)
+	// GenerateNumber draws a number, host only.
+	GenerateNumber(ctx context.Context, in *GenerateNumberRequest, opts ...grpc.CallOption) (*Draw, error)
+	GetDraws(ctx context.Context, in *GetDrawsRequest, opts ...grpc.CallOption) (*GetDrawsReply, error)
+	// SetCalls sets call names numbers are announced with, host only.
+	SetCalls(ctx context.Context, in *SetCallsRequest, opts ...grpc.CallOption) (*SetCallsReply, error)
+	GetStats(ctx context.Context, in *GameRequest, opts ...grpc.CallOption) (*Stats, error)
+	ClaimPrize(ctx context.Context, in *ClaimPrizeRequest, opts ...grpc.CallOption) (*ClaimPrizeReply, error)
+	// WatchGame streams events of a game, replaying numbers drawn after last_event_id first.
+	WatchGame(ctx context.Context, in *WatchGameRequest, opts ...grpc.CallOption) (Bingo_WatchGameClient, error)
+	// StartGame, PauseGame, ResumeGame and EndGame change the status of a game, host only.
+	StartGame(ctx context.Context, in *GameRequest, opts ...grpc.CallOption) (*StatusReply, error)
+	PauseGame(ctx context.Context, in *GameRequest, opts ...grpc.CallOption) (*StatusReply, error)
+	ResumeGame(ctx context.Context, in *GameRequest, opts ...grpc.CallOption) (*StatusReply, error)
+	EndGame(ctx context.Context, in *GameRequest, opts ...grpc.CallOption) (*StatusReply, error)
+	GetSeed(ctx context.Context, in *GameRequest, opts ...grpc.CallOption) (*GetSeedReply, error)
+	// StartAutoCall and StopAutoCall turn drawing numbers on a schedule on and off, host only.
+	StartAutoCall(ctx context.Context, in *StartAutoCallRequest, opts ...grpc.CallOption) (*AutoCallReply, error)
+	StopAutoCall(ctx context.Context, in *GameRequest, opts ...grpc.CallOption) (*AutoCallReply, error)
+}
+
+type bingoClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewBingoClient(cc *grpc.ClientConn) BingoClient {
+	return &bingoClient{cc}
+}
+
+func (c *bingoClient) CreateGame(ctx context.Context, in *CreateGameRequest, opts ...grpc.CallOption) (*CreateGameReply, error) {
+	out := new(CreateGameReply)
+	err := c.cc.Invoke(ctx, "/pb.Bingo/CreateGame", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bingoClient) AuthorizeHost(ctx context.Context, in *AuthorizeHostRequest, opts ...grpc.CallOption) (*AuthorizeHostReply, error) {
+	out := new(AuthorizeHostReply)
+	err := c.cc.Invoke(ctx, "/pb.Bingo/AuthorizeHost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bingoClient) CreateTicket(ctx context.Context, in *CreateTicketRequest, opts ...grpc.CallOption) (*CreateTicketReply, error) {
+	out := new(CreateTicketReply)
+	err := c.cc.Invoke(ctx, "/pb.Bingo/CreateTicket", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bingoClient) CreateTicketStrip(ctx context.Context, in *CreateTicketRequest, opts ...grpc.CallOption) (*CreateTicketStripReply, error) {
+	out := new(CreateTicketStripReply)
+	err := c.cc.Invoke(ctx, "/pb.Bingo/CreateTicketStrip", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bingoClient) ShowTicket(ctx context.Context, in *ShowTicketRequest, opts ...grpc.CallOption) (*Ticket, error) {
+	out := new(Ticket)
+	err := c.cc.Invoke(ctx, "/pb.Bingo/ShowTicket", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bingoClient) ListTickets(ctx context.Context, in *ListTicketsRequest, opts ...grpc.CallOption) (*ListTicketsReply, error) {
+	out := new(ListTicketsReply)
+	err := c.cc.Invoke(ctx, "/pb.Bingo/ListTickets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bingoClient) MarkNumber(ctx context.Context, in *MarkNumberRequest, opts ...grpc.CallOption) (*MarkNumberReply, error) {
+	out := new(MarkNumberReply)
+	err := c.cc.Invoke(ctx, "/pb.Bingo/MarkNumber", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bingoClient) UnmarkNumber(ctx context.Context, in *MarkNumberRequest, opts ...grpc.CallOption) (*MarkNumberReply, error) {
+	out := new(MarkNumberReply)
+	err := c.cc.Invoke(ctx, "/pb.Bingo/UnmarkNumber", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bingoClient) SetAutoDaub(ctx context.Context, in *SetAutoDaubRequest, opts ...grpc.CallOption) (*SetAutoDaubReply, error) {
+	out := new(SetAutoDaubReply)
+	err := c.cc.Invoke(ctx, "/pb.Bingo/SetAutoDaub", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bingoClient) GenerateNumber(ctx context.Context, in *GenerateNumberRequest, opts ...grpc.CallOption) (*Draw, error) {
+	out := new(Draw)
+	err := c.cc.Invoke(ctx, "/pb.Bingo/GenerateNumber", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bingoClient) GetDraws(ctx context.Context, in *GetDrawsRequest, opts ...grpc.CallOption) (*GetDrawsReply, error) {
+	out := new(GetDrawsReply)
+	err := c.cc.Invoke(ctx, "/pb.Bingo/GetDraws", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bingoClient) SetCalls(ctx context.Context, in *SetCallsRequest, opts ...grpc.CallOption) (*SetCallsReply, error) {
+	out := new(SetCallsReply)
+	err := c.cc.Invoke(ctx, "/pb.Bingo/SetCalls", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bingoClient) GetStats(ctx context.Context, in *GameRequest, opts ...grpc.CallOption) (*Stats, error) {
+	out := new(Stats)
+	err := c.cc.Invoke(ctx, "/pb.Bingo/GetStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bingoClient) ClaimPrize(ctx context.Context, in *ClaimPrizeRequest, opts ...grpc.CallOption) (*ClaimPrizeReply, error) {
+	out := new(ClaimPrizeReply)
+	err := c.cc.Invoke(ctx, "/pb.Bingo/ClaimPrize", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bingoClient) WatchGame(ctx context.Context, in *WatchGameRequest, opts ...grpc.CallOption) (Bingo_WatchGameClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Bingo_serviceDesc.Streams[0], "/pb.Bingo/WatchGame", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &bingoWatchGameClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Bingo_WatchGameClient interface {
+	Recv() (*Event, error)
+	grpc.ClientStream
+}
+
+type bingoWatchGameClient struct {
+	grpc.ClientStream
+}
+
+func (x *bingoWatchGameClient) Recv() (*Event, error) {
+	m := new(Event)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *bingoClient) StartGame(ctx context.Context, in *GameRequest, opts ...grpc.CallOption) (*StatusReply, error) {
+	out := new(StatusReply)
+	err := c.cc.Invoke(ctx, "/pb.Bingo/StartGame", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bingoClient) PauseGame(ctx context.Context, in *GameRequest, opts ...grpc.CallOption) (*StatusReply, error) {
+	out := new(StatusReply)
+	err := c.cc.Invoke(ctx, "/pb.Bingo/PauseGame", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bingoClient) ResumeGame(ctx context.Context, in *GameRequest, opts ...grpc.CallOption) (*StatusReply, error) {
+	out := new(StatusReply)
+	err := c.cc.Invoke(ctx, "/pb.Bingo/ResumeGame", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bingoClient) EndGame(ctx context.Context, in *GameRequest, opts ...grpc.CallOption) (*StatusReply, error) {
+	out := new(StatusReply)
+	err := c.cc.Invoke(ctx, "/pb.Bingo/EndGame", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bingoClient) GetSeed(ctx context.Context, in *GameRequest, opts ...grpc.CallOption) (*GetSeedReply, error) {
+	out := new(GetSeedReply)
+	err := c.cc.Invoke(ctx, "/pb.Bingo/GetSeed", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bingoClient) StartAutoCall(ctx context.Context, in *StartAutoCallRequest, opts ...grpc.CallOption) (*AutoCallReply, error) {
+	out := new(AutoCallReply)
+	err := c.cc.Invoke(ctx, "/pb.Bingo/StartAutoCall", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bingoClient) StopAutoCall(ctx context.Context, in *GameRequest, opts ...grpc.CallOption) (*AutoCallReply, error) {
+	out := new(AutoCallReply)
+	err := c.cc.Invoke(ctx, "/pb.Bingo/StopAutoCall", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BingoServer is the server API for Bingo service.
+type BingoServer interface {
+	// CreateGame creates a game hosted by the authenticated player, or only with its host secret when called anonymously.
+	CreateGame(context.Context, *CreateGameRequest) (*CreateGameReply, error)
+	// AuthorizeHost checks the host secret of a game.
+	AuthorizeHost(context.Context, *AuthorizeHostRequest) (*AuthorizeHostReply, error)
+	CreateTicket(context.Context, *CreateTicketRequest) (*CreateTicketReply, error)
+	CreateTicketStrip(context.Context, *CreateTicketRequest) (*CreateTicketStripReply, error)
+	ShowTicket(context.Context, *ShowTicketRequest) (*Ticket, error)
+	ListTickets(context.Context, *ListTicketsRequest) (*ListTicketsReply, error)
+	MarkNumber(context.Context, *MarkNumberRequest) (*MarkNumberReply, error)
+	UnmarkNumber(context.Context, *MarkNumberRequest) (*MarkNumberReply, error)
+	SetAutoDaub(context.Context, *SetAutoDaubRequest) (*SetAutoDaubReply, error)
+	// GenerateNumber draws a number, host only.
+	GenerateNumber(context.Context, *GenerateNumberRequest) (*Draw, error)
+	GetDraws(context.Context, *GetDrawsRequest) (*GetDrawsReply, error)
+	// SetCalls sets call names numbers are announced with, host only.
+	SetCalls(context.Context, *SetCallsRequest) (*SetCallsReply, error)
+	GetStats(context.Context, *GameRequest) (*Stats, error)
+	ClaimPrize(context.Context, *ClaimPrizeRequest) (*ClaimPrizeReply, error)
+	// WatchGame streams events of a game, replaying numbers drawn after last_event_id first.
+	WatchGame(*WatchGameRequest, Bingo_WatchGameServer) error
+	// StartGame, PauseGame, ResumeGame and EndGame change the status of a game, host only.
+	StartGame(context.Context, *GameRequest) (*StatusReply, error)
+	PauseGame(context.Context, *GameRequest) (*StatusReply, error)
+	ResumeGame(context.Context, *GameRequest) (*StatusReply, error)
+	EndGame(context.Context, *GameRequest) (*StatusReply, error)
+	GetSeed(context.Context, *GameRequest) (*GetSeedReply, error)
+	// StartAutoCall and StopAutoCall turn drawing numbers on a schedule on and off, host only.
+	StartAutoCall(context.Context, *StartAutoCallRequest) (*AutoCallReply, error)
+	StopAutoCall(context.Context, *GameRequest) (*AutoCallReply, error)
+}
+
+// UnimplementedBingoServer can be embedded to have forward compatible implementations.
+type UnimplementedBingoServer struct {
+}
+
+func (*UnimplementedBingoServer) CreateGame(ctx context.Context, req *CreateGameRequest) (*CreateGameReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGame not implemented")
+}
+func (*UnimplementedBingoServer) AuthorizeHost(ctx context.Context, req *AuthorizeHostRequest) (*AuthorizeHostReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthorizeHost not implemented")
+}
+func (*UnimplementedBingoServer) CreateTicket(ctx context.Context, req *CreateTicketRequest) (*CreateTicketReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTicket not implemented")
+}
+func (*UnimplementedBingoServer) CreateTicketStrip(ctx context.Context, req *CreateTicketRequest) (*CreateTicketStripReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTicketStrip not implemented")
+}
+func (*UnimplementedBingoServer) ShowTicket(ctx context.Context, req *ShowTicketRequest) (*Ticket, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShowTicket not implemented")
+}
+func (*UnimplementedBingoServer) ListTickets(ctx context.Context, req *ListTicketsRequest) (*ListTicketsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTickets not implemented")
+}
+func (*UnimplementedBingoServer) MarkNumber(ctx context.Context, req *MarkNumberRequest) (*MarkNumberReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkNumber not implemented")
+}
+func (*UnimplementedBingoServer) UnmarkNumber(ctx context.Context, req *MarkNumberRequest) (*MarkNumberReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnmarkNumber not implemented")
+}
+func (*UnimplementedBingoServer) SetAutoDaub(ctx context.Context, req *SetAutoDaubRequest) (*SetAutoDaubReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAutoDaub not implemented")
+}
+func (*UnimplementedBingoServer) GenerateNumber(ctx context.Context, req *GenerateNumberRequest) (*Draw, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateNumber not implemented")
+}
+func (*UnimplementedBingoServer) GetDraws(ctx context.Context, req *GetDrawsRequest) (*GetDrawsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDraws not implemented")
+}
+func (*UnimplementedBingoServer) SetCalls(ctx context.Context, req *SetCallsRequest) (*SetCallsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCalls not implemented")
+}
+func (*UnimplementedBingoServer) GetStats(ctx context.Context, req *GameRequest) (*Stats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStats not implemented")
+}
+func (*UnimplementedBingoServer) ClaimPrize(ctx context.Context, req *ClaimPrizeRequest) (*ClaimPrizeReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimPrize not implemented")
+}
+func (*UnimplementedBingoServer) WatchGame(req *WatchGameRequest, srv Bingo_WatchGameServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchGame not implemented")
+}
+func (*UnimplementedBingoServer) StartGame(ctx context.Context, req *GameRequest) (*StatusReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartGame not implemented")
+}
+func (*UnimplementedBingoServer) PauseGame(ctx context.Context, req *GameRequest) (*StatusReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseGame not implemented")
+}
+func (*UnimplementedBingoServer) ResumeGame(ctx context.Context, req *GameRequest) (*StatusReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeGame not implemented")
+}
+func (*UnimplementedBingoServer) EndGame(ctx context.Context, req *GameRequest) (*StatusReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EndGame not implemented")
+}
+func (*UnimplementedBingoServer) GetSeed(ctx context.Context, req *GameRequest) (*GetSeedReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSeed not implemented")
+}
+func (*UnimplementedBingoServer) StartAutoCall(ctx context.Context, req *StartAutoCallRequest) (*AutoCallReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartAutoCall not implemented")
+}
+func (*UnimplementedBingoServer) StopAutoCall(ctx context.Context, req *GameRequest) (*AutoCallReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopAutoCall not implemented")
+}
+
+func RegisterBingoServer(s *grpc.Server, srv BingoServer) {
+	s.RegisterService(&_Bingo_serviceDesc, srv)
+}
+
+func _Bingo_CreateGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BingoServer).CreateGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Bingo/CreateGame",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BingoServer).CreateGame(ctx, req.(*CreateGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bingo_AuthorizeHost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthorizeHostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BingoServer).AuthorizeHost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Bingo/AuthorizeHost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BingoServer).AuthorizeHost(ctx, req.(*AuthorizeHostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bingo_CreateTicket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTicketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BingoServer).CreateTicket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Bingo/CreateTicket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BingoServer).CreateTicket(ctx, req.(*CreateTicketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bingo_CreateTicketStrip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTicketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BingoServer).CreateTicketStrip(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Bingo/CreateTicketStrip",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BingoServer).CreateTicketStrip(ctx, req.(*CreateTicketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bingo_ShowTicket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShowTicketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BingoServer).ShowTicket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Bingo/ShowTicket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BingoServer).ShowTicket(ctx, req.(*ShowTicketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bingo_ListTickets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTicketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BingoServer).ListTickets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Bingo/ListTickets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BingoServer).ListTickets(ctx, req.(*ListTicketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bingo_MarkNumber_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkNumberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BingoServer).MarkNumber(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Bingo/MarkNumber",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BingoServer).MarkNumber(ctx, req.(*MarkNumberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bingo_UnmarkNumber_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkNumberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BingoServer).UnmarkNumber(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Bingo/UnmarkNumber",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BingoServer).UnmarkNumber(ctx, req.(*MarkNumberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bingo_SetAutoDaub_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAutoDaubRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BingoServer).SetAutoDaub(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Bingo/SetAutoDaub",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BingoServer).SetAutoDaub(ctx, req.(*SetAutoDaubRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bingo_GenerateNumber_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateNumberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BingoServer).GenerateNumber(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Bingo/GenerateNumber",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BingoServer).GenerateNumber(ctx, req.(*GenerateNumberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bingo_GetDraws_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDrawsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BingoServer).GetDraws(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Bingo/GetDraws",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BingoServer).GetDraws(ctx, req.(*GetDrawsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bingo_SetCalls_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCallsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BingoServer).SetCalls(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Bingo/SetCalls",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BingoServer).SetCalls(ctx, req.(*SetCallsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bingo_GetStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BingoServer).GetStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Bingo/GetStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BingoServer).GetStats(ctx, req.(*GameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bingo_ClaimPrize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimPrizeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BingoServer).ClaimPrize(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Bingo/ClaimPrize",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BingoServer).ClaimPrize(ctx, req.(*ClaimPrizeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bingo_WatchGame_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchGameRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BingoServer).WatchGame(m, &bingoWatchGameServer{stream})
+}
+
+type Bingo_WatchGameServer interface {
+	Send(*Event) error
+	grpc.ServerStream
+}
+
+type bingoWatchGameServer struct {
+	grpc.ServerStream
+}
+
+func (x *bingoWatchGameServer) Send(m *Event) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Bingo_StartGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BingoServer).StartGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Bingo/StartGame",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BingoServer).StartGame(ctx, req.(*GameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bingo_PauseGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BingoServer).PauseGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Bingo/PauseGame",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BingoServer).PauseGame(ctx, req.(*GameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bingo_ResumeGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BingoServer).ResumeGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Bingo/ResumeGame",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BingoServer).ResumeGame(ctx, req.(*GameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bingo_EndGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BingoServer).EndGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Bingo/EndGame",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BingoServer).EndGame(ctx, req.(*GameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bingo_GetSeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BingoServer).GetSeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Bingo/GetSeed",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BingoServer).GetSeed(ctx, req.(*GameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bingo_StartAutoCall_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartAutoCallRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BingoServer).StartAutoCall(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Bingo/StartAutoCall",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BingoServer).StartAutoCall(ctx, req.(*StartAutoCallRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bingo_StopAutoCall_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BingoServer).StopAutoCall(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Bingo/StopAutoCall",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BingoServer).StopAutoCall(ctx, req.(*GameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Bingo_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "pb.Bingo",
+	HandlerType: (*BingoServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateGame",
+			Handler:    _Bingo_CreateGame_Handler,
+		},
+		{
+			MethodName: "AuthorizeHost",
+			Handler:    _Bingo_AuthorizeHost_Handler,
+		},
+		{
+			MethodName: "CreateTicket",
+			Handler:    _Bingo_CreateTicket_Handler,
+		},
+		{
+			MethodName: "CreateTicketStrip",
+			Handler:    _Bingo_CreateTicketStrip_Handler,
+		},
+		{
+			MethodName: "ShowTicket",
+			Handler:    _Bingo_ShowTicket_Handler,
+		},
+		{
+			MethodName: "ListTickets",
+			Handler:    _Bingo_ListTickets_Handler,
+		},
+		{
+			MethodName: "MarkNumber",
+			Handler:    _Bingo_MarkNumber_Handler,
+		},
+		{
+			MethodName: "UnmarkNumber",
+			Handler:    _Bingo_UnmarkNumber_Handler,
+		},
+		{
+			MethodName: "SetAutoDaub",
+			Handler:    _Bingo_SetAutoDaub_Handler,
+		},
+		{
+			MethodName: "GenerateNumber",
+			Handler:    _Bingo_GenerateNumber_Handler,
+		},
+		{
+			MethodName: "GetDraws",
+			Handler:    _Bingo_GetDraws_Handler,
+		},
+		{
+			MethodName: "SetCalls",
+			Handler:    _Bingo_SetCalls_Handler,
+		},
+		{
+			MethodName: "GetStats",
+			Handler:    _Bingo_GetStats_Handler,
+		},
+		{
+			MethodName: "ClaimPrize",
+			Handler:    _Bingo_ClaimPrize_Handler,
+		},
+		{
+			MethodName: "StartGame",
+			Handler:    _Bingo_StartGame_Handler,
+		},
+		{
+			MethodName: "PauseGame",
+			Handler:    _Bingo_PauseGame_Handler,
+		},
+		{
+			MethodName: "ResumeGame",
+			Handler:    _Bingo_ResumeGame_Handler,
+		},
+		{
+			MethodName: "EndGame",
+			Handler:    _Bingo_EndGame_Handler,
+		},
+		{
+			MethodName: "GetSeed",
+			Handler:    _Bingo_GetSeed_Handler,
+		},
+		{
+			MethodName: "StartAutoCall",
+			Handler:    _Bingo_StartAutoCall_Handler,
+		},
+		{
+			MethodName: "StopAutoCall",
+			Handler:    _Bingo_StopAutoCall_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchGame",
+			Handler:       _Bingo_WatchGame_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "bingo.proto",
+}
@@ -0,0 +1,4 @@
+// Package pb holds the protobuf definition of the gRPC API of the bingo service, and code generated from it.
+package pb
+
+//go:generate protoc --go_out=plugins=grpc,paths=source_relative:. bingo.proto
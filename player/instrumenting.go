@@ -0,0 +1,44 @@
+package player
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kit/kit/metrics"
+)
+
+// observe records in latency the seconds since begin of a call to method of repository.
+func observe(latency metrics.Histogram, repository, method string, begin time.Time) {
+	latency.With("repository", repository, "method", method).Observe(time.Since(begin).Seconds())
+}
+
+type instrumentingRepository struct {
+	latency metrics.Histogram
+	next    Repository
+}
+
+// NewInstrumentingRepository returns a Repository which records latency of calls to r in seconds,
+// labelled by repository and method.
+func NewInstrumentingRepository(latency metrics.Histogram, r Repository) Repository {
+	return instrumentingRepository{latency: latency, next: r}
+}
+
+func (r instrumentingRepository) Insert(ctx context.Context, p Player) error {
+	defer observe(r.latency, "players", "insert", time.Now())
+	return r.next.Insert(ctx, p)
+}
+
+func (r instrumentingRepository) Find(ctx context.Context, username string) (Player, error) {
+	defer observe(r.latency, "players", "find", time.Now())
+	return r.next.Find(ctx, username)
+}
+
+func (r instrumentingRepository) InsertSession(ctx context.Context, sess Session) error {
+	defer observe(r.latency, "players", "insert_session", time.Now())
+	return r.next.InsertSession(ctx, sess)
+}
+
+func (r instrumentingRepository) FindSession(ctx context.Context, tokenHash string) (Session, error) {
+	defer observe(r.latency, "players", "find_session", time.Now())
+	return r.next.FindSession(ctx, tokenHash)
+}
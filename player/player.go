@@ -0,0 +1,93 @@
+package player
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/divyanshgaba/bingo/errs"
+	"golang.org/x/text/unicode/norm"
+)
+
+// errors for package player
+var (
+	ErrUsernameTaken = errs.New("player.username_taken", http.StatusConflict, "player: username is taken")
+	ErrNotFound      = errs.New("player.not_found", http.StatusNotFound, "player: not found")
+	ErrInvalidToken  = errs.New("player.invalid_token", http.StatusUnauthorized, "player: invalid or expired session token")
+)
+
+// Player is an account tickets are bought with.
+type Player struct {
+	Username     string
+	PasswordHash []byte
+	CreatedAt    time.Time
+}
+
+// Session authenticates a player with a token until it expires. Only a hash of the token is stored.
+type Session struct {
+	TokenHash string
+	Username  string
+	ExpiresAt time.Time
+}
+
+// Repository provides access a player store.
+type Repository interface {
+	// Insert stores a new player, returns ErrUsernameTaken if a player with the username exists.
+	Insert(context.Context, Player) error
+	Find(ctx context.Context, username string) (Player, error)
+	InsertSession(context.Context, Session) error
+	// FindSession returns the session with tokenHash, returns ErrInvalidToken if there is none or it expired.
+	FindSession(ctx context.Context, tokenHash string) (Session, error)
+}
+
+// limits of usernames, in characters
+const (
+	MinUsernameLength = 3
+	MaxUsernameLength = 32
+)
+
+var usernameChars = regexp.MustCompile(`^[A-Za-z0-9_.-]*$`)
+
+// NormalizeUsername returns username in the form players are stored with: compatibility characters, such as
+// full-width letters, are replaced with their plain form, surrounding spaces are removed and letters are lower
+// case, so usernames differing only in case name the same player. Returns an error telling why the username is
+// invalid otherwise, which is safe to show to clients.
+func NormalizeUsername(username string) (string, error) {
+	if len(username) > 4*MaxUsernameLength {
+		return "", errUsernameLength
+	}
+	username = strings.TrimSpace(norm.NFKC.String(username))
+	if n := utf8.RuneCountInString(username); n < MinUsernameLength || n > MaxUsernameLength {
+		return "", errUsernameLength
+	}
+	if !usernameChars.MatchString(username) {
+		return "", errUsernameChars
+	}
+	return strings.ToLower(username), nil
+}
+
+// reasons a username is invalid
+var (
+	errUsernameLength = fmt.Errorf("must be %d to %d characters long", MinUsernameLength, MaxUsernameLength)
+	errUsernameChars  = errors.New("may only contain letters, digits, '_', '.' and '-'")
+)
+
+type contextKey int
+
+const usernameKey contextKey = iota
+
+// NewContext returns a copy of ctx carrying the username of the authenticated player.
+func NewContext(ctx context.Context, username string) context.Context {
+	return context.WithValue(ctx, usernameKey, username)
+}
+
+// FromContext returns the username of the authenticated player carried by ctx, if any.
+func FromContext(ctx context.Context) (string, bool) {
+	username, ok := ctx.Value(usernameKey).(string)
+	return username, ok
+}
@@ -0,0 +1,127 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/divyanshgaba/bingo/bingo"
+	"github.com/divyanshgaba/bingo/game"
+)
+
+func TestRegisterAndLogin(t *testing.T) {
+	register := func(body string) int {
+		resp, _ := http.Post(srvURL+"/api/player/register", "application/json", strings.NewReader(body))
+		resp.Body.Close()
+		return resp.StatusCode
+	}
+	if code := register(`{"username":"grace","password":"` + password + `"}`); code != 200 {
+		t.Errorf("invalid status code: want=200 got=%v", code)
+	}
+	if code := register(`{"username":"grace","password":"` + password + `"}`); code != http.StatusConflict {
+		t.Errorf("invalid status code for taken username: want=409 got=%v", code)
+	}
+	if code := register(`{"username":"ada","password":"short"}`); code != http.StatusBadRequest {
+		t.Errorf("invalid status code for short password: want=400 got=%v", code)
+	}
+
+	resp, _ := http.Post(srvURL+"/api/player/login", "application/json", strings.NewReader(`{"username":"grace","password":"wrong"}`))
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Errorf("invalid status code for wrong password: want=401 got=%v", resp.StatusCode)
+	}
+	resp, _ = http.Post(srvURL+"/api/player/login", "application/json", strings.NewReader(`{"username":"grace","password":"`+password+`"}`))
+	if resp.StatusCode != 200 {
+		t.Fatalf("invalid status code: want=200 got=%v", resp.StatusCode)
+	}
+	login := struct {
+		Token string `json:"token"`
+	}{}
+	json.NewDecoder(resp.Body).Decode(&login)
+	if login.Token == "" {
+		t.Errorf("empty token in response body")
+	}
+	var cookie *http.Cookie
+	for _, c := range resp.Cookies() {
+		if c.Name == "bingo_session" {
+			cookie = c
+		}
+	}
+	if cookie == nil || cookie.Value != login.Token || !cookie.HttpOnly {
+		t.Fatalf("session cookie not set: %v", resp.Cookies())
+	}
+
+	// the cookie lets browsers view their tickets
+	id := createGame(t, "")
+	resp, _ = clientFor("grace").Post(srvURL+"/api/game/"+id+"/ticket/grace/generate", "application/json", nil)
+	created := struct {
+		TicketID string `json:"ticket_id"`
+	}{}
+	json.NewDecoder(resp.Body).Decode(&created)
+	req, _ := http.NewRequest(http.MethodGet, srvURL+"/ticket/"+created.TicketID, nil)
+	req.Header.Set("Authorization", "none")
+	req.AddCookie(cookie)
+	resp, _ = http.DefaultClient.Do(req)
+	if resp.StatusCode != 200 {
+		t.Errorf("invalid status code for ticket viewed with cookie: want=200 got=%v", resp.StatusCode)
+	}
+}
+
+func TestTicketOwnership(t *testing.T) {
+	id := createGame(t, "") // hosted by testUser
+	resp, _ := clientFor("owner").Post(srvURL+"/api/game/"+id+"/ticket/owner/generate", "application/json", nil)
+	created := struct {
+		TicketID string `json:"ticket_id"`
+	}{}
+	json.NewDecoder(resp.Body).Decode(&created)
+	ticketURL := srvURL + "/api/ticket/" + created.TicketID
+
+	tests := []struct {
+		name   string
+		client *http.Client
+		want   int
+	}{
+		{"owner", clientFor("owner"), http.StatusOK},
+		{"host", http.DefaultClient, http.StatusOK},
+		{"other player", clientFor("mallory"), http.StatusForbidden},
+		{"anonymous", &http.Client{}, http.StatusUnauthorized},
+	}
+	for _, tc := range tests {
+		resp, _ := tc.client.Get(ticketURL)
+		if resp.StatusCode != tc.want {
+			t.Errorf("invalid status code for %v viewing ticket: want=%v got=%v", tc.name, tc.want, resp.StatusCode)
+		}
+		resp, _ = tc.client.Post(srvURL+"/api/game/"+id+"/ticket/"+created.TicketID+"/claim", "application/json", strings.NewReader(`{"prize":"early_five"}`))
+		if tc.want != http.StatusOK && resp.StatusCode != tc.want {
+			t.Errorf("invalid status code for %v claiming: want=%v got=%v", tc.name, tc.want, resp.StatusCode)
+		}
+	}
+
+	resp, _ = clientFor("mallory").Post(srvURL+"/api/game/"+id+"/ticket/owner/generate", "application/json", nil)
+	if resp.StatusCode != http.StatusForbidden {
+		t.Errorf("invalid status code for ticket bought for someone else: want=403 got=%v", resp.StatusCode)
+	}
+
+	// games created anonymously have no host, they are hosted with the host secret only
+	resp, _ = (&http.Client{}).Post(srvURL+"/api/game/create", "application/json", nil)
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("invalid status code for anonymous game creation: want=200 got=%v", resp.StatusCode)
+	}
+	var anonymous struct {
+		GameID     string `json:"game_id"`
+		HostSecret string `json:"host_secret"`
+	}
+	json.NewDecoder(resp.Body).Decode(&anonymous)
+	if g, _ := games.Find(context.Background(), game.ID(anonymous.GameID)); g.Host != "" {
+		t.Errorf("anonymous game has host %v", g.Host)
+	}
+	req, _ := http.NewRequest(http.MethodPost, srvURL+"/api/game/"+anonymous.GameID+"/start", nil)
+	if resp, _ := (&http.Client{}).Do(req); resp.StatusCode != http.StatusForbidden {
+		t.Errorf("invalid status code for starting anonymous game without secret: want=403 got=%v", resp.StatusCode)
+	}
+	req.Header.Set(bingo.HostSecretHeader, anonymous.HostSecret)
+	if resp, _ := (&http.Client{}).Do(req); resp.StatusCode != http.StatusOK {
+		t.Errorf("invalid status code for starting anonymous game with secret: want=200 got=%v", resp.StatusCode)
+	}
+}
@@ -0,0 +1,128 @@
+package tests
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/divyanshgaba/bingo/bingo"
+	"github.com/divyanshgaba/bingo/game"
+	"github.com/divyanshgaba/bingo/ticket"
+)
+
+// waitFor polls until cond holds, for at most 5 seconds.
+func waitFor(t *testing.T, what string, cond func() bool) {
+	deadline := time.Now().Add(5 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for %v", what)
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+}
+
+// startAutoCall starts auto-calling a game created with createGame.
+func startAutoCall(id, body string) *http.Response {
+	req := hostRequest(http.MethodPost, id, "/autocall/start")
+	req.Body = ioutil.NopCloser(strings.NewReader(body))
+	resp, _ := http.DefaultClient.Do(req)
+	return resp
+}
+
+func numbersDrawn(id string) int {
+	g, _ := games.Find(context.Background(), game.ID(id))
+	return len(g.Numbers)
+}
+
+func TestAutoCall(t *testing.T) {
+	id := createGame(t, "")
+	startGame(t, id)
+	resp, _ := http.Post(srvURL+"/api/game/"+id+"/autocall/start", "application/json", strings.NewReader(`{"interval":"100ms"}`))
+	if resp.StatusCode != http.StatusForbidden {
+		t.Errorf("invalid status code without host secret: want=403 got=%v", resp.StatusCode)
+	}
+
+	resp = startAutoCall(id, `{"interval":"1ms"}`)
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("invalid status code for too short interval: want=400 got=%v", resp.StatusCode)
+	}
+
+	resp = startAutoCall(id, `{"interval":"100ms"}`)
+	if resp.StatusCode != 200 {
+		t.Fatalf("invalid status code: want=200 got=%v", resp.StatusCode)
+	}
+	waitFor(t, "numbers to be drawn", func() bool { return numbersDrawn(id) >= 2 })
+
+	resp, _ = http.DefaultClient.Do(hostRequest(http.MethodPost, id, "/autocall/stop"))
+	if resp.StatusCode != 200 {
+		t.Fatalf("invalid status code: want=200 got=%v", resp.StatusCode)
+	}
+	time.Sleep(50 * time.Millisecond) // let a draw in flight finish
+	drawn := numbersDrawn(id)
+	time.Sleep(300 * time.Millisecond)
+	if n := numbersDrawn(id); n != drawn {
+		t.Errorf("numbers drawn after auto-calling stopped: want=%v got=%v", drawn, n)
+	}
+}
+
+func TestAutoCallStopsWhenFinished(t *testing.T) {
+	id := createGame(t, `{"format":"custom","rows":1,"cols":3,"numbers":3,"min":1,"max":3}`)
+	startGame(t, id)
+	resp := startAutoCall(id, `{"interval":"100ms"}`)
+	if resp.StatusCode != 200 {
+		t.Fatalf("invalid status code: want=200 got=%v", resp.StatusCode)
+	}
+	waitFor(t, "game to finish", func() bool {
+		g, _ := games.Find(context.Background(), game.ID(id))
+		return g.Status == game.Finished && g.AutoCall == 0
+	})
+	if n := numbersDrawn(id); n != 3 {
+		t.Errorf("invalid count of numbers drawn: want=3 got=%v", n)
+	}
+}
+
+func TestResumeAutoCalls(t *testing.T) {
+	// a game auto-called by a process which has since stopped
+	id, err := games.Insert(context.Background(), game.Game{Status: game.Running, Tickets: []ticket.ID{}, Numbers: []int64{}})
+	if err != nil {
+		t.Fatalf("could not insert game err=%v", err)
+	}
+	if err := games.SetAutoCall(context.Background(), id, 100*time.Millisecond); err != nil {
+		t.Fatalf("could not set auto-call err=%v", err)
+	}
+
+	bs := bingo.NewService(games, tickets, transactor, bingo.NewCryptoSource())
+	if err := bingo.ResumeAutoCalls(context.Background(), bs, games); err != nil {
+		t.Fatalf("could not resume auto-calls err=%v", err)
+	}
+	waitFor(t, "numbers to be drawn", func() bool { return numbersDrawn(string(id)) >= 1 })
+	if err := bs.StopAutoCall(context.Background(), id); err != nil {
+		t.Errorf("could not stop auto-call err=%v", err)
+	}
+}
+
+func TestAutoCallInstances(t *testing.T) {
+	// two instances of the service auto-calling the same game draw one number every interval between them
+	id, err := games.Insert(context.Background(), game.Game{Status: game.Running, Tickets: []ticket.ID{}, Numbers: []int64{}})
+	if err != nil {
+		t.Fatalf("could not insert game err=%v", err)
+	}
+	instances := []bingo.Service{
+		bingo.NewService(games, tickets, transactor, bingo.NewCryptoSource()),
+		bingo.NewService(games, tickets, transactor, bingo.NewCryptoSource()),
+	}
+	for _, bs := range instances {
+		if err := bs.StartAutoCall(context.Background(), id, 200*time.Millisecond); err != nil {
+			t.Fatalf("could not start auto-call err=%v", err)
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+	time.Sleep(time.Second)
+	instances[0].StopAutoCall(context.Background(), id)
+	if n := numbersDrawn(string(id)); n < 3 || n > 6 {
+		t.Errorf("invalid count of numbers drawn in a second at 200ms: want=5 got=%v", n)
+	}
+}
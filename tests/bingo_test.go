@@ -4,8 +4,12 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/divyanshgaba/bingo/bingo"
+	"github.com/divyanshgaba/bingo/game"
 	"github.com/divyanshgaba/bingo/ticket"
 )
 
@@ -49,11 +53,12 @@ func TestCreateTicket(t *testing.T) {
 	if err != nil {
 		t.Errorf("error while retrieving ticket ID=%v err=%v", ticketResp.TicketID, err)
 	}
-	if ticketStore.Username != "testUser" {
-		t.Errorf("error invalid username want=testUser got=%v", ticketStore.Username)
+	if ticketStore.Username != "testuser" {
+		t.Errorf("error invalid username want=testuser got=%v", ticketStore.Username)
 	}
 }
 func TestGenerateNumber(t *testing.T) {
+	startGame(t, string(gameID))
 	req, _ := http.NewRequest(http.MethodGet, srvURL+"/api/game/"+string(gameID)+"/number/random", nil)
 	resp, _ := http.DefaultClient.Do(req)
 	if resp.StatusCode != 200 {
@@ -90,6 +95,44 @@ func TestGetAllNumbers(t *testing.T) {
 		t.Errorf("number not set in response body")
 	}
 }
+func TestDrawHistory(t *testing.T) {
+	id := createGame(t, "")
+	startGame(t, id)
+	for i := 0; i < 3; i++ {
+		drawNumber(t, game.ID(id))
+	}
+	resp, _ := http.Get(srvURL + "/api/game/" + id + "/numbers?since=1")
+	if resp.StatusCode != 200 {
+		t.Fatalf("invalid status code: want=200 got=%v", resp.StatusCode)
+	}
+	history := struct {
+		Numbers []int64 `json:"numbers"`
+		Draws   []struct {
+			Seq     int        `json:"seq"`
+			Number  int64      `json:"number"`
+			DrawnAt *time.Time `json:"drawn_at"`
+			Caller  string     `json:"caller"`
+		} `json:"draws"`
+	}{}
+	json.NewDecoder(resp.Body).Decode(&history)
+	if len(history.Draws) != 2 || len(history.Numbers) != 2 {
+		t.Fatalf("invalid count of draws since 1: want=2 got=%v", len(history.Draws))
+	}
+	for i, d := range history.Draws {
+		if d.Seq != i+2 || d.Number != history.Numbers[i] {
+			t.Errorf("invalid draw %v: seq=%v number=%v", i, d.Seq, d.Number)
+		}
+		if d.DrawnAt == nil || d.Caller != "testuser" {
+			t.Errorf("invalid draw metadata: drawn_at=%v caller=%v", d.DrawnAt, d.Caller)
+		}
+	}
+
+	resp, _ = http.Get(srvURL + "/api/game/" + id + "/numbers?since=-1")
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("invalid status code for negative since: want=400 got=%v", resp.StatusCode)
+	}
+}
+
 func TestGetStats(t *testing.T) {
 	req, _ := http.NewRequest(http.MethodGet, srvURL+"/api/game/"+string(gameID)+"/stats", nil)
 	resp, _ := http.DefaultClient.Do(req)
@@ -112,3 +155,131 @@ func TestGetStats(t *testing.T) {
 		t.Errorf("number not set in response body")
 	}
 }
+
+func TestClaimPrize(t *testing.T) {
+	id, _ := games.Insert(context.Background(), game.Game{Host: "testuser", Status: game.Lobby})
+	req, _ := http.NewRequest(http.MethodPost, srvURL+"/api/game/"+string(id)+"/ticket/testUser/generate", nil)
+	resp, _ := http.DefaultClient.Do(req)
+	ticketResp := struct {
+		TicketID string `json:"ticket_id,omitempty"`
+	}{}
+	json.NewDecoder(resp.Body).Decode(&ticketResp)
+	claimURL := srvURL + "/api/game/" + string(id) + "/ticket/" + ticketResp.TicketID + "/claim"
+
+	// no numbers drawn yet
+	resp, _ = http.Post(claimURL, "application/json", strings.NewReader(`{"prize":"full_house"}`))
+	if resp.StatusCode != http.StatusUnprocessableEntity {
+		t.Errorf("invalid status code for bogus claim: want=422 got=%v", resp.StatusCode)
+	}
+	resp, _ = http.Post(claimURL, "application/json", strings.NewReader(`{"prize":"two_lines"}`))
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("invalid status code for unknown prize: want=400 got=%v", resp.StatusCode)
+	}
+
+	startGame(t, string(id))
+	for i := 0; i < 100; i++ {
+		req, _ := http.NewRequest(http.MethodGet, srvURL+"/api/game/"+string(id)+"/number/random", nil)
+		http.DefaultClient.Do(req)
+	}
+	resp, _ = http.Post(claimURL, "application/json", strings.NewReader(`{"prize":"full_house"}`))
+	if resp.StatusCode != 200 {
+		t.Errorf("invalid status code: want=200 got=%v", resp.StatusCode)
+	}
+	g, _ := games.Find(context.Background(), id)
+	if w, ok := g.Winner(game.FullHouse); !ok || string(w.Ticket) != ticketResp.TicketID {
+		t.Errorf("winner not recorded for full house, got=%v", g.Winners)
+	}
+	resp, _ = http.Post(claimURL, "application/json", strings.NewReader(`{"prize":"full_house"}`))
+	if resp.StatusCode != http.StatusConflict {
+		t.Errorf("invalid status code for duplicate claim: want=409 got=%v", resp.StatusCode)
+	}
+}
+
+func TestGameLifecycle(t *testing.T) {
+	id := createGame(t, "")
+
+	steps := []struct {
+		method, path string
+		want         int
+	}{
+		{http.MethodPost, "/pause", http.StatusConflict},
+		{http.MethodPost, "/start", http.StatusOK},
+		{http.MethodPost, "/ticket/testUser/generate", http.StatusConflict},
+		{http.MethodGet, "/number/random", http.StatusOK},
+		{http.MethodPost, "/pause", http.StatusOK},
+		{http.MethodGet, "/number/random", http.StatusConflict},
+		{http.MethodPost, "/resume", http.StatusOK},
+		{http.MethodGet, "/number/random", http.StatusOK},
+		{http.MethodPost, "/end", http.StatusOK},
+		{http.MethodGet, "/number/random", http.StatusConflict},
+		{http.MethodPost, "/end", http.StatusConflict},
+	}
+	for _, step := range steps {
+		resp, _ := http.DefaultClient.Do(hostRequest(step.method, id, step.path))
+		if resp.StatusCode != step.want {
+			t.Errorf("invalid status code for %v %v: want=%v got=%v", step.method, step.path, step.want, resp.StatusCode)
+		}
+	}
+	g, _ := games.Find(context.Background(), game.ID(id))
+	if g.Status != game.Finished {
+		t.Errorf("invalid status: want=%v got=%v", game.Finished, g.Status)
+	}
+}
+
+func TestHostSecret(t *testing.T) {
+	id := createGame(t, "")
+	if secret, _ := hostSecrets.Load(id); secret == "" {
+		t.Fatalf("empty host secret in create game response")
+	}
+	gameURL := srvURL + "/api/game/" + id
+
+	tests := []struct {
+		name   string
+		secret string
+		want   int
+	}{
+		{"missing secret", "", http.StatusForbidden},
+		{"wrong secret", "not-the-secret", http.StatusForbidden},
+	}
+	for _, tc := range tests {
+		req, _ := http.NewRequest(http.MethodPost, gameURL+"/start", nil)
+		req.Header.Set(bingo.HostSecretHeader, tc.secret)
+		resp, _ := http.DefaultClient.Do(req)
+		if resp.StatusCode != tc.want {
+			t.Errorf("invalid status code for %v: want=%v got=%v", tc.name, tc.want, resp.StatusCode)
+		}
+	}
+
+	resp, _ := http.DefaultClient.Do(hostRequest(http.MethodPost, id, "/start"))
+	if resp.StatusCode != 200 {
+		t.Errorf("invalid status code with host secret: want=200 got=%v", resp.StatusCode)
+	}
+	drawNumber(t, game.ID(id))
+	for _, path := range []string{"/numbers", "/stats"} {
+		resp, _ := http.Get(gameURL + path)
+		if resp.StatusCode != 200 {
+			t.Errorf("invalid status code for %v without host secret: want=200 got=%v", path, resp.StatusCode)
+		}
+	}
+}
+
+func TestHostWithoutSecret(t *testing.T) {
+	// games created before host secrets can only be hosted by their host
+	id, _ := games.Insert(context.Background(), game.Game{Host: "alice", Status: game.Lobby})
+	startURL := srvURL + "/api/game/" + string(id) + "/start"
+
+	resp, _ := http.Post(startURL, "application/json", nil)
+	if resp.StatusCode != http.StatusForbidden {
+		t.Errorf("invalid status code for another player: want=403 got=%v", resp.StatusCode)
+	}
+	req, _ := http.NewRequest(http.MethodPost, startURL, nil)
+	req.Header.Set("Authorization", "Bearer not-a-token")
+	resp, _ = http.DefaultClient.Do(req)
+	if resp.StatusCode != http.StatusForbidden {
+		t.Errorf("invalid status code without session: want=403 got=%v", resp.StatusCode)
+	}
+	resp, _ = clientFor("alice").Post(startURL, "application/json", nil)
+	if resp.StatusCode != 200 {
+		t.Errorf("invalid status code for host: want=200 got=%v", resp.StatusCode)
+	}
+}
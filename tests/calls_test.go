@@ -0,0 +1,95 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/divyanshgaba/bingo/game"
+	"github.com/divyanshgaba/bingo/ticket"
+)
+
+// announcements returns announcements of numbers drawn in a game, with call names of locale.
+func announcements(t *testing.T, id game.ID, locale string) []string {
+	resp, _ := http.Get(srvURL + "/api/game/" + string(id) + "/numbers?locale=" + locale)
+	if resp.StatusCode != 200 {
+		t.Fatalf("invalid status code for locale %q: want=200 got=%v", locale, resp.StatusCode)
+	}
+	history := struct {
+		Draws []struct {
+			Announcement string `json:"announcement"`
+		} `json:"draws"`
+	}{}
+	json.NewDecoder(resp.Body).Decode(&history)
+	var texts []string
+	for _, d := range history.Draws {
+		texts = append(texts, d.Announcement)
+	}
+	return texts
+}
+
+func TestCallNames(t *testing.T) {
+	id, _ := games.Insert(context.Background(), game.Game{Host: "testuser", Status: game.Running, Format: game.NinetyBall, Tickets: []ticket.ID{}, Numbers: []int64{22, 7}})
+	setCalls := func(body string) int {
+		resp, _ := http.Post(srvURL+"/api/game/"+string(id)+"/calls", "application/json", strings.NewReader(body))
+		resp.Body.Close()
+		return resp.StatusCode
+	}
+	if code := setCalls(`{"locale":"custom","names":{"91":"Out of range"}}`); code != http.StatusBadRequest {
+		t.Errorf("invalid status code for name of number not in game: want=400 got=%v", code)
+	}
+	if code := setCalls(`{"locale":"klingon"}`); code != http.StatusBadRequest {
+		t.Errorf("invalid status code for unknown locale: want=400 got=%v", code)
+	}
+	if code := setCalls(`{"locale":"custom","names":{"22":"Quack quack"}}`); code != 200 {
+		t.Fatalf("invalid status code: want=200 got=%v", code)
+	}
+
+	tests := []struct {
+		locale string
+		want   []string
+	}{
+		{"", []string{"Quack quack, twenty-two", "Seven"}},
+		{"plain", []string{"Twenty-two", "Seven"}},
+		{"uk", []string{"Two little ducks, twenty-two", "Lucky seven, seven"}},
+	}
+	for _, tc := range tests {
+		got := announcements(t, id, tc.locale)
+		if strings.Join(got, "|") != strings.Join(tc.want, "|") {
+			t.Errorf("invalid announcements for locale %q: want=%q got=%q", tc.locale, tc.want, got)
+		}
+	}
+	resp, _ := http.Get(srvURL + "/api/game/" + string(id) + "/numbers?locale=klingon")
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("invalid status code for unknown locale: want=400 got=%v", resp.StatusCode)
+	}
+
+	id, _ = games.Insert(context.Background(), game.Game{Host: "testuser", Status: game.Running, Format: game.SeventyFiveBall, Tickets: []ticket.ID{}, Numbers: []int64{12, 75}})
+	want := []string{"B, twelve", "O, seventy-five"}
+	if got := announcements(t, id, ""); strings.Join(got, "|") != strings.Join(want, "|") {
+		t.Errorf("invalid announcements for 75-ball game: want=%q got=%q", want, got)
+	}
+}
+
+func TestDrawAnnouncement(t *testing.T) {
+	id := createGame(t, `{"format":"90-ball"}`)
+	startGame(t, id)
+	resp, _ := http.DefaultClient.Do(hostRequest(http.MethodGet, id, "/number/random?locale=uk"))
+	if resp.StatusCode != 200 {
+		t.Fatalf("invalid status code: want=200 got=%v", resp.StatusCode)
+	}
+	drawn := struct {
+		Number       int64  `json:"number"`
+		Seq          int    `json:"seq"`
+		Announcement string `json:"announcement"`
+	}{}
+	json.NewDecoder(resp.Body).Decode(&drawn)
+	if drawn.Seq != 1 || drawn.Announcement == "" {
+		t.Errorf("invalid draw: seq=%v announcement=%q", drawn.Seq, drawn.Announcement)
+	}
+	if history := announcements(t, game.ID(id), "uk"); len(history) != 1 || history[0] != drawn.Announcement {
+		t.Errorf("announcement in history differs from draw: want=%q got=%q", drawn.Announcement, history)
+	}
+}
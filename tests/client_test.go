@@ -0,0 +1,272 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/divyanshgaba/bingo/account"
+	"github.com/divyanshgaba/bingo/bingo"
+	"github.com/divyanshgaba/bingo/client"
+	"github.com/divyanshgaba/bingo/errs"
+	"github.com/divyanshgaba/bingo/game"
+	"github.com/divyanshgaba/bingo/player"
+	"github.com/divyanshgaba/bingo/ticket"
+)
+
+// remoteFor returns a client of the test server acting as player username.
+func remoteFor(t *testing.T, username string) bingo.Service {
+	clientFor(username)
+	token, _, err := accounts.Login(context.Background(), username, password)
+	if err != nil {
+		t.Fatalf("could not login err=%v", err)
+	}
+	c, err := client.New(srvURL, client.WithToken(token))
+	if err != nil {
+		t.Fatalf("could not create client err=%v", err)
+	}
+	return c
+}
+
+func TestClient(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	remote := remoteFor(t, "testUser")
+	id, secret, err := remote.CreateGame(ctx, game.Format{Name: game.Format90Ball}, game.Rules{})
+	if err != nil {
+		t.Fatalf("could not create game err=%v", err)
+	}
+	ticketID, err := remote.CreateTicket(ctx, id, "testUser")
+	if err != nil {
+		t.Fatalf("could not create ticket err=%v", err)
+	}
+	if err := remote.StartGame(ctx, id); err != nil {
+		t.Fatalf("could not start game err=%v", err)
+	}
+	events, err := remote.Watch(ctx, id, 0)
+	if err != nil {
+		t.Fatalf("could not watch game err=%v", err)
+	}
+	drawn, err := remote.GenerateNumber(ctx, id, bingo.LocaleUK)
+	if err != nil {
+		t.Fatalf("could not draw number err=%v", err)
+	}
+	for e := range events {
+		if e.Type == bingo.EventNumberDrawn {
+			if e.Number == nil || *e.Number != drawn.Number {
+				t.Errorf("invalid number drawn event: want=%v got=%v", drawn.Number, e.Number)
+			}
+			break
+		}
+	}
+
+	local := bingo.NewService(games, tickets, transactor, bingo.NewCryptoSource())
+	want, err := local.ShowTicket(player.NewContext(ctx, "testuser"), ticketID)
+	if err != nil {
+		t.Fatalf("could not show ticket err=%v", err)
+	}
+	tk, err := remote.ShowTicket(ctx, ticketID)
+	if err != nil {
+		t.Fatalf("could not show ticket err=%v", err)
+	}
+	if tk.Rows != want.Rows || tk.Cols != want.Cols || fmt.Sprint(tk.Cells, tk.Drawn) != fmt.Sprint(want.Cells, want.Drawn) {
+		t.Errorf("invalid ticket: want=%v got=%v", want, tk)
+	}
+	draws, err := remote.GetDraws(ctx, id, 0, "")
+	if err != nil || len(draws) != 1 || draws[0].Number != drawn.Number {
+		t.Errorf("invalid draws: %v err=%v", draws, err)
+	}
+	stats, err := remote.GetStats(ctx, id)
+	if err != nil || stats.NumbersDrawn != 1 || stats.TicketsGenerated != 1 {
+		t.Errorf("invalid stats: %+v err=%v", stats, err)
+	}
+
+	// errors come back as the errors of the service
+	other := remoteFor(t, "alice")
+	if _, err := other.GenerateNumber(ctx, id, ""); err != bingo.ErrInvalidHostSecret {
+		t.Errorf("invalid error without host secret: want=%v got=%v", bingo.ErrInvalidHostSecret, err)
+	}
+	if err := other.AuthorizeHost(ctx, id, "wrong"); err != bingo.ErrInvalidHostSecret {
+		t.Errorf("invalid error for wrong host secret: want=%v got=%v", bingo.ErrInvalidHostSecret, err)
+	}
+	if err := other.AuthorizeHost(ctx, id, secret); err != nil {
+		t.Fatalf("could not authorize host err=%v", err)
+	}
+	if err := other.EndGame(ctx, id); err != nil {
+		t.Errorf("could not end game as authorized host err=%v", err)
+	}
+	if err := other.MarkNumber(ctx, ticketID, drawn.Number); err != bingo.ErrForbidden {
+		t.Errorf("invalid error marking ticket of another player: want=%v got=%v", bingo.ErrForbidden, err)
+	}
+	if _, err := other.GetStats(ctx, unknownID); err != game.ErrInvalidID {
+		t.Errorf("invalid error for unknown game: want=%v got=%v", game.ErrInvalidID, err)
+	}
+	if _, err := other.ShowTicket(ctx, ticket.ID(unknownID)); err != ticket.ErrInvalidID {
+		t.Errorf("invalid error for unknown ticket: want=%v got=%v", ticket.ErrInvalidID, err)
+	}
+	_, err = other.GetStats(ctx, "not-a-game")
+	var invalid *errs.Error
+	if !errors.Is(err, bingo.ErrInvalidArgument) || !errors.As(err, &invalid) || len(invalid.Fields) != 1 {
+		t.Errorf("invalid error for malformed game ID: want=%v got=%#v", bingo.ErrInvalidArgument, err)
+	}
+	anonymous, _ := client.New(srvURL, client.WithHTTPClient(&http.Client{}))
+	if _, err := anonymous.ShowTicket(ctx, ticket.ID(unknownID)); err != account.ErrUnauthenticated {
+		t.Errorf("invalid error without session token: want=%v got=%v", account.ErrUnauthenticated, err)
+	}
+}
+
+// TestClientRoundTrip checks the client returns what the local service does, for every method of the service.
+func TestClientRoundTrip(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	remote := remoteFor(t, "testUser")
+	local := bingo.NewService(games, tickets, transactor, bingo.NewCryptoSource())
+	localCtx := player.NewContext(ctx, "testuser")
+	same := func(method string, got, want interface{}) {
+		t.Helper()
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("invalid %s: want=%+v got=%+v", method, want, got)
+		}
+	}
+
+	format, rules := game.Format{Name: game.Format90Ball}, game.Rules{MaxTicketsPerUser: 10}
+	id, secret, err := remote.CreateGame(ctx, format, rules)
+	if err != nil {
+		t.Fatalf("could not create game err=%v", err)
+	}
+	localID, _, err := local.CreateGame(localCtx, format, rules)
+	if err != nil {
+		t.Fatalf("could not create game err=%v", err)
+	}
+	g, _ := games.Find(ctx, id)
+	want, _ := games.Find(ctx, localID)
+	same("CreateGame", []interface{}{g.Format, g.Rules, g.Status, g.Host}, []interface{}{want.Format, want.Rules, want.Status, want.Host})
+	same("AuthorizeHost", remote.AuthorizeHost(ctx, id, secret), local.AuthorizeHost(localCtx, id, secret))
+	same("AuthorizeHost", remote.AuthorizeHost(ctx, id, "wrong"), local.AuthorizeHost(localCtx, id, "wrong"))
+
+	ticketID, err := remote.CreateTicket(ctx, id, "testUser")
+	if err != nil {
+		t.Fatalf("could not create ticket err=%v", err)
+	}
+	stripID, stripTickets, err := remote.CreateTicketStrip(ctx, id, "testUser")
+	if err != nil {
+		t.Fatalf("could not create strip err=%v", err)
+	}
+	strip, err := local.ShowTicket(localCtx, stripTickets[0])
+	if err != nil || strip.StripID != stripID || len(stripTickets) != 6 {
+		t.Errorf("invalid strip %v of tickets %v: %+v err=%v", stripID, stripTickets, strip, err)
+	}
+	if err := remote.StartAutoCall(ctx, id, 10*time.Minute); err != nil {
+		t.Errorf("could not start auto-call err=%v", err)
+	}
+	if g, _ := games.Find(ctx, id); g.AutoCall != 10*time.Minute {
+		t.Errorf("invalid auto-call interval: want=%v got=%v", 10*time.Minute, g.AutoCall)
+	}
+	if err := remote.StopAutoCall(ctx, id); err != nil {
+		t.Errorf("could not stop auto-call err=%v", err)
+	}
+	if g, _ := games.Find(ctx, id); g.AutoCall != 0 {
+		t.Errorf("invalid auto-call interval: want=0 got=%v", g.AutoCall)
+	}
+	calls := game.Calls{Locale: bingo.LocaleCustom, Custom: map[int64]string{1: "Kelly's eye"}}
+	if err := remote.SetCalls(ctx, id, calls); err != nil {
+		t.Errorf("could not set calls err=%v", err)
+	}
+	if g, _ := games.Find(ctx, id); !reflect.DeepEqual(g.Calls, calls) {
+		t.Errorf("invalid calls: want=%+v got=%+v", calls, g.Calls)
+	}
+	if err := remote.StartGame(ctx, id); err != nil {
+		t.Fatalf("could not start game err=%v", err)
+	}
+	same("ResumeGame", remote.ResumeGame(ctx, id), local.ResumeGame(localCtx, id))
+
+	// draw until a number is on the ticket, so it can be daubed
+	var onTicket int64
+	for onTicket == 0 {
+		drawn, err := remote.GenerateNumber(ctx, id, "")
+		if err != nil {
+			t.Fatalf("could not draw number err=%v", err)
+		}
+		draws, err := local.GetDraws(localCtx, id, drawn.Seq-1, "")
+		if err != nil || len(draws) != 1 {
+			t.Fatalf("could not get draws %v err=%v", draws, err)
+		}
+		// stores may keep draw times less precisely than they are returned when drawn
+		if drawn.DrawnAt == nil || draws[0].DrawnAt == nil || drawn.DrawnAt.Sub(*draws[0].DrawnAt).Round(time.Millisecond) != 0 {
+			t.Errorf("invalid draw time: want=%v got=%v", draws[0].DrawnAt, drawn.DrawnAt)
+		}
+		drawn.DrawnAt = draws[0].DrawnAt
+		same("GenerateNumber", drawn, draws[0])
+		tk, err := local.ShowTicket(localCtx, ticketID)
+		if err != nil {
+			t.Fatalf("could not show ticket err=%v", err)
+		}
+		if len(tk.Drawn) > 0 {
+			onTicket = tk.Drawn[0]
+		}
+	}
+	same("MarkNumber", remote.MarkNumber(ctx, ticketID, onTicket), local.MarkNumber(localCtx, ticketID, onTicket))
+	same("MarkNumber", remote.MarkNumber(ctx, ticketID, 91), local.MarkNumber(localCtx, ticketID, 91))
+	same("UnmarkNumber", remote.UnmarkNumber(ctx, stripTickets[0], onTicket), local.UnmarkNumber(localCtx, stripTickets[0], onTicket))
+	same("SetAutoDaub", remote.SetAutoDaub(ctx, stripTickets[1], true), local.SetAutoDaub(localCtx, stripTickets[1], true))
+	same("ClaimPrize", remote.ClaimPrize(ctx, id, ticketID, game.FullHouse), local.ClaimPrize(localCtx, id, ticketID, game.FullHouse))
+	same("PauseGame", remote.PauseGame(ctx, id), nil)
+	same("PauseGame", remote.PauseGame(ctx, id), local.PauseGame(localCtx, id))
+	same("ResumeGame", remote.ResumeGame(ctx, id), nil)
+
+	for _, id := range append([]ticket.ID{ticketID}, stripTickets...) {
+		got, gotErr := remote.ShowTicket(ctx, id)
+		want, wantErr := local.ShowTicket(localCtx, id)
+		same("ShowTicket", []interface{}{got, gotErr}, []interface{}{want, wantErr})
+	}
+	got, gotTotal, gotErr := remote.ListTickets(ctx, id, 1, 3)
+	listed, total, err := local.ListTickets(localCtx, id, 1, 3)
+	same("ListTickets", []interface{}{got, gotTotal, gotErr}, []interface{}{listed, total, err})
+	for _, locale := range []string{"", bingo.LocaleUK} {
+		got, gotErr := remote.GetDraws(ctx, id, 0, locale)
+		want, wantErr := local.GetDraws(localCtx, id, 0, locale)
+		same("GetDraws", []interface{}{got, gotErr}, []interface{}{want, wantErr})
+	}
+	gotStats, gotErr := remote.GetStats(ctx, id)
+	stats, err := local.GetStats(localCtx, id)
+	same("GetStats", []interface{}{gotStats, gotErr}, []interface{}{stats, err})
+
+	same("EndGame", remote.EndGame(ctx, id), nil)
+	same("EndGame", remote.EndGame(ctx, id), local.EndGame(localCtx, id))
+	same("StartGame", remote.StartGame(ctx, id), local.StartGame(localCtx, id))
+	gotHash, gotSeed, gotErr := remote.GetSeed(ctx, id)
+	hash, seed, err := local.GetSeed(localCtx, id)
+	same("GetSeed", []interface{}{gotHash, gotSeed, gotErr}, []interface{}{hash, seed, err})
+	_, gotErr = remote.GenerateNumber(ctx, id, "")
+	_, err = local.GenerateNumber(localCtx, id, "")
+	same("GenerateNumber", gotErr, err)
+	_, gotErr = remote.CreateTicket(ctx, id, "testUser")
+	_, err = local.CreateTicket(localCtx, id, "testuser")
+	same("CreateTicket", gotErr, err)
+	_, _, gotErr = remote.CreateTicketStrip(ctx, id, "testUser")
+	_, _, err = local.CreateTicketStrip(localCtx, id, "testuser")
+	same("CreateTicketStrip", gotErr, err)
+
+	// every number drawn is replayed, then the game finishing
+	draws, _ := local.GetDraws(localCtx, id, 0, "")
+	watch := func(s bingo.Service, ctx context.Context) []bingo.Event {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		events, err := s.Watch(ctx, id, 0)
+		if err != nil {
+			t.Fatalf("could not watch game err=%v", err)
+		}
+		var replayed []bingo.Event
+		for e := range events {
+			if replayed = append(replayed, e); len(replayed) == len(draws)+1 {
+				break
+			}
+		}
+		return replayed
+	}
+	same("Watch", watch(remote, ctx), watch(local, localCtx))
+}
@@ -0,0 +1,81 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/divyanshgaba/bingo/bingo"
+	"github.com/divyanshgaba/bingo/game"
+	"github.com/go-kit/kit/log"
+)
+
+// TestConcurrentDraws draws numbers of one game from many goroutines, through two instances of the service sharing a store.
+func TestConcurrentDraws(t *testing.T) {
+	logger := log.NewLogfmtLogger(log.NewSyncWriter(os.Stderr))
+	var urls []string
+	for i := 0; i < 2; i++ {
+		bs := bingo.NewService(games, tickets, transactor, bingo.NewCryptoSource())
+		srv := httptest.NewServer(bingo.MakeHandler(bs, accounts, logger))
+		defer srv.Close()
+		urls = append(urls, srv.URL)
+	}
+	id, _ := games.Insert(context.Background(), game.Game{Host: "testuser", Status: game.Running})
+
+	var (
+		wg    sync.WaitGroup
+		mtx   sync.Mutex
+		drawn []int64
+	)
+	for i := 0; i < 40; i++ {
+		wg.Add(1)
+		go func(url string) {
+			defer wg.Done()
+			for attempt := 0; attempt < 500; attempt++ {
+				resp, err := http.Get(url + "/api/game/" + string(id) + "/number/random")
+				if err != nil {
+					t.Errorf("error while drawing number err=%v", err)
+					return
+				}
+				body := struct {
+					Number int64 `json:"number"`
+				}{}
+				json.NewDecoder(resp.Body).Decode(&body)
+				resp.Body.Close()
+				switch resp.StatusCode {
+				case http.StatusOK:
+					mtx.Lock()
+					drawn = append(drawn, body.Number)
+					mtx.Unlock()
+				case http.StatusBadRequest: // all numbers drawn
+					return
+				}
+			}
+		}(urls[i%2])
+	}
+	wg.Wait()
+
+	if len(drawn) != 100 {
+		t.Errorf("invalid count of numbers drawn: want=100 got=%v", len(drawn))
+	}
+	seen := make(map[int64]bool)
+	for _, n := range drawn {
+		if seen[n] {
+			t.Errorf("number %v drawn more than once", n)
+		}
+		seen[n] = true
+	}
+	g, _ := games.Find(context.Background(), id)
+	if len(g.Numbers) != 100 || g.Status != game.Finished {
+		t.Errorf("invalid game after draws: numbers=%v status=%v", len(g.Numbers), g.Status)
+	}
+	for _, n := range g.Numbers {
+		if !seen[n] {
+			t.Errorf("number %v stored but not returned to any caller", n)
+		}
+	}
+}
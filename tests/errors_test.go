@@ -0,0 +1,69 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/divyanshgaba/bingo/bingo"
+	"github.com/divyanshgaba/bingo/errs"
+	"github.com/divyanshgaba/bingo/game"
+	"github.com/divyanshgaba/bingo/ticket"
+	"github.com/go-kit/kit/log"
+)
+
+// unknownID is a well-formed ID of no game or ticket.
+const unknownID = "000000000000000000000000"
+
+// brokenGames is a game repository whose store is down.
+type brokenGames struct {
+	game.Repository
+}
+
+func (brokenGames) Find(context.Context, game.ID) (game.Game, error) {
+	return game.Game{}, errors.New("server selection error: mongo-0.internal:27017 connection refused")
+}
+
+func TestProblemDetails(t *testing.T) {
+	broken := httptest.NewServer(bingo.MakeHandler(
+		bingo.NewValidatingService(bingo.NewService(brokenGames{games}, tickets, transactor, bingo.NewSeededSource(1))),
+		accounts, log.NewNopLogger()))
+	defer broken.Close()
+
+	tests := []struct {
+		method, url string
+		status      int
+		code        string
+		detail      string
+	}{
+		{http.MethodGet, srvURL + "/api/game/" + unknownID + "/stats", http.StatusBadRequest, "game.invalid_id", game.ErrInvalidID.Message},
+		{http.MethodGet, srvURL + "/api/ticket/" + unknownID, http.StatusBadRequest, "ticket.invalid_id", ticket.ErrInvalidID.Message},
+		{http.MethodGet, srvURL + "/api/no/such/route", http.StatusNotFound, "bingo.bad_route", "bingo: bad route"},
+		{http.MethodGet, broken.URL + "/api/game/" + string(gameID) + "/stats", http.StatusInternalServerError, "internal", "internal error"},
+	}
+	for _, tc := range tests {
+		req, _ := http.NewRequest(tc.method, tc.url, nil)
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("could not request %v err=%v", tc.url, err)
+		}
+		if ct := resp.Header.Get("Content-Type"); !strings.HasPrefix(ct, errs.ProblemContentType) {
+			t.Errorf("invalid content type for %v: %v", tc.url, ct)
+		}
+		body := readBody(resp)
+		var p errs.Problem
+		if err := json.Unmarshal([]byte(body), &p); err != nil {
+			t.Fatalf("invalid problem details for %v: %v", tc.url, body)
+		}
+		if resp.StatusCode != tc.status || p.Status != tc.status || p.Code != tc.code || p.Detail != tc.detail {
+			t.Errorf("invalid problem details for %v: status=%v %v", tc.url, resp.StatusCode, body)
+		}
+		if strings.Contains(body, "mongo") {
+			t.Errorf("store error leaked to client: %v", body)
+		}
+	}
+}
@@ -0,0 +1,147 @@
+package tests
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/divyanshgaba/bingo/game"
+	"github.com/gorilla/websocket"
+)
+
+type event struct {
+	ID     int64  `json:"id"`
+	Type   string `json:"type"`
+	Number *int64 `json:"number,omitempty"`
+	// idLine is the id line sent with the event in a stream, if any
+	idLine string
+}
+
+func drawNumber(t *testing.T, id game.ID) {
+	resp, err := http.DefaultClient.Do(hostRequest(http.MethodGet, string(id), "/number/random"))
+	if err != nil || resp.StatusCode != 200 {
+		t.Fatalf("could not draw number err=%v", err)
+	}
+	resp.Body.Close()
+}
+
+func TestEventStream(t *testing.T) {
+	id, _ := games.Insert(context.Background(), game.Game{Host: "testuser", Status: game.Running})
+	drawNumber(t, id)
+	drawNumber(t, id)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	events := watchEvents(ctx, t, id, "1")
+
+	// replayed from the game, only the second draw is after Last-Event-ID
+	e := <-events
+	if e.ID != 2 || e.Type != "number_drawn" || e.Number == nil {
+		t.Errorf("invalid replayed event: want id=2 type=number_drawn got=%+v", e)
+	}
+	drawNumber(t, id)
+	e = <-events
+	if e.ID != 3 || e.Type != "number_drawn" {
+		t.Errorf("invalid live event: want id=3 type=number_drawn got=%+v", e)
+	}
+}
+
+// watchEvents opens the event stream of a game, resuming after lastEventID unless it is empty.
+func watchEvents(ctx context.Context, t *testing.T, id game.ID, lastEventID string) <-chan event {
+	req, _ := http.NewRequest(http.MethodGet, srvURL+"/api/game/"+string(id)+"/events", nil)
+	req = req.WithContext(ctx)
+	if lastEventID != "" {
+		req.Header.Set("Last-Event-ID", lastEventID)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("error while opening event stream err=%v", err)
+	}
+	if ct := resp.Header.Get("Content-Type"); ct != "text/event-stream" {
+		t.Errorf("invalid content type: want=text/event-stream got=%v", ct)
+	}
+	events := make(chan event)
+	go func() {
+		defer resp.Body.Close()
+		sc := bufio.NewScanner(resp.Body)
+		idLine := ""
+		for sc.Scan() {
+			line := sc.Text()
+			if strings.HasPrefix(line, "id: ") {
+				idLine = line
+			}
+			if strings.HasPrefix(line, "data: ") {
+				e := event{idLine: idLine}
+				json.Unmarshal([]byte(strings.TrimPrefix(line, "data: ")), &e)
+				events <- e
+				idLine = ""
+			}
+		}
+		close(events)
+	}()
+	return events
+}
+
+func TestEventStreamIDs(t *testing.T) {
+	id, _ := games.Insert(context.Background(), game.Game{Host: "testuser", Status: game.Lobby})
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	events := watchEvents(ctx, t, id, "")
+
+	// only draws have an ID, other events leave the last event ID of the client as it is
+	req, _ := http.NewRequest(http.MethodPost, srvURL+"/api/game/"+string(id)+"/ticket/testUser/generate", nil)
+	http.DefaultClient.Do(req)
+	if e := <-events; e.Type != "ticket_created" || e.ID != 0 || e.idLine != "" {
+		t.Errorf("invalid live event: want type=ticket_created without id got=%+v", e)
+	}
+	startGame(t, string(id))
+	drawNumber(t, id)
+	if e := <-events; e.Type != "number_drawn" || e.ID != 1 || e.idLine != "id: 1" {
+		t.Errorf("invalid live event: want id=1 type=number_drawn got=%+v", e)
+	}
+	http.DefaultClient.Do(hostRequest(http.MethodPost, string(id), "/end"))
+	if e := <-events; e.Type != "game_finished" || e.ID != 0 || e.idLine != "" {
+		t.Errorf("invalid live event: want type=game_finished without id got=%+v", e)
+	}
+
+	// resuming after the last draw replays only the end of the game
+	events = watchEvents(ctx, t, id, "1")
+	if e := <-events; e.Type != "game_finished" || e.ID != 0 || e.idLine != "" {
+		t.Errorf("invalid replayed event: want type=game_finished without id got=%+v", e)
+	}
+}
+
+func TestEventSocket(t *testing.T) {
+	id, _ := games.Insert(context.Background(), game.Game{Host: "testuser", Status: game.Lobby})
+
+	wsURL := "ws" + strings.TrimPrefix(srvURL, "http") + "/api/game/" + string(id) + "/events/ws"
+	conn, _, err := websocket.DefaultDialer.Dial(wsURL, nil)
+	if err != nil {
+		t.Fatalf("error while opening websocket err=%v", err)
+	}
+	defer conn.Close()
+	conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+
+	var e event
+	req, _ := http.NewRequest(http.MethodPost, srvURL+"/api/game/"+string(id)+"/ticket/testUser/generate", nil)
+	http.DefaultClient.Do(req)
+	if err := conn.ReadJSON(&e); err != nil || e.Type != "ticket_created" {
+		t.Errorf("invalid live event: want type=ticket_created got=%+v err=%v", e, err)
+	}
+	startGame(t, string(id))
+	drawNumber(t, id)
+	if err := conn.ReadJSON(&e); err != nil || e.ID != 1 || e.Type != "number_drawn" {
+		t.Errorf("invalid live event: want id=1 type=number_drawn got=%+v err=%v", e, err)
+	}
+}
+
+func TestEventStreamInvalidGame(t *testing.T) {
+	resp, _ := http.Get(srvURL + "/api/game/invalid/events")
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("invalid status code: want=400 got=%v", resp.StatusCode)
+	}
+}
@@ -0,0 +1,249 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/divyanshgaba/bingo/bingo"
+	"github.com/divyanshgaba/bingo/game"
+	"github.com/divyanshgaba/bingo/ticket"
+)
+
+func createGame(t *testing.T, body string) string {
+	resp, err := http.Post(srvURL+"/api/game/create", "application/json", strings.NewReader(body))
+	if err != nil || resp.StatusCode != 200 {
+		t.Fatalf("could not create game body=%v err=%v", body, err)
+	}
+	created := struct {
+		GameID     string `json:"game_id,omitempty"`
+		HostSecret string `json:"host_secret,omitempty"`
+	}{}
+	json.NewDecoder(resp.Body).Decode(&created)
+	hostSecrets.Store(created.GameID, created.HostSecret)
+	return created.GameID
+}
+
+// hostSecrets holds the host secret of every game created through createGame.
+var hostSecrets sync.Map
+
+// hostRequest returns a request for a host operation on a game, carrying its host secret.
+func hostRequest(method, gameID, path string) *http.Request {
+	req, _ := http.NewRequest(method, srvURL+"/api/game/"+gameID+path, nil)
+	if secret, ok := hostSecrets.Load(gameID); ok {
+		req.Header.Set(bingo.HostSecretHeader, secret.(string))
+	}
+	return req
+}
+
+// startGame starts a game, numbers can only be drawn once it is running.
+func startGame(t *testing.T, gameID string) {
+	resp, err := http.DefaultClient.Do(hostRequest(http.MethodPost, gameID, "/start"))
+	if err != nil || resp.StatusCode != 200 {
+		t.Fatalf("could not start game err=%v", err)
+	}
+	resp.Body.Close()
+}
+
+func createTicket(t *testing.T, gameID string) ticket.Ticket {
+	req, _ := http.NewRequest(http.MethodPost, srvURL+"/api/game/"+gameID+"/ticket/testUser/generate", nil)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil || resp.StatusCode != 200 {
+		t.Fatalf("could not create ticket err=%v", err)
+	}
+	created := struct {
+		TicketID string `json:"ticket_id,omitempty"`
+	}{}
+	json.NewDecoder(resp.Body).Decode(&created)
+	tk, err := tickets.Find(context.Background(), ticket.ID(created.TicketID))
+	if err != nil {
+		t.Fatalf("could not find ticket err=%v", err)
+	}
+	return tk
+}
+
+func cellValues(tk ticket.Ticket) []int64 {
+	var cells []int64
+	for _, c := range strings.Split(tk.Cells, ";") {
+		n, _ := strconv.ParseInt(c, 10, 64)
+		cells = append(cells, n)
+	}
+	return cells
+}
+
+func TestNinetyBallTickets(t *testing.T) {
+	id := createGame(t, `{"format":"90-ball"}`)
+	for i := 0; i < 50; i++ {
+		tk := createTicket(t, id)
+		cells := cellValues(tk)
+		if tk.Rows != 3 || tk.Cols != 9 || len(cells) != 27 {
+			t.Fatalf("invalid ticket shape rows=%v cols=%v cells=%v", tk.Rows, tk.Cols, len(cells))
+		}
+		for r := 0; r < 3; r++ {
+			count := 0
+			for c := 0; c < 9; c++ {
+				if cells[r*9+c] != -1 {
+					count++
+				}
+			}
+			if count != 5 {
+				t.Errorf("invalid numbers in row %v: want=5 got=%v cells=%v", r, count, cells)
+			}
+		}
+		for c := 0; c < 9; c++ {
+			lo, hi := int64(c*10), int64(c*10+9)
+			if c == 0 {
+				lo = 1
+			}
+			if c == 8 {
+				hi = 90
+			}
+			prev, count := int64(0), 0
+			for r := 0; r < 3; r++ {
+				n := cells[r*9+c]
+				if n == -1 {
+					continue
+				}
+				count++
+				if n < lo || n > hi {
+					t.Errorf("number %v out of range [%v,%v] of column %v", n, lo, hi, c)
+				}
+				if n <= prev {
+					t.Errorf("column %v not sorted cells=%v", c, cells)
+				}
+				prev = n
+			}
+			if count == 0 {
+				t.Errorf("empty column %v cells=%v", c, cells)
+			}
+		}
+	}
+}
+
+func TestSeventyFiveBallTickets(t *testing.T) {
+	id := createGame(t, `{"format":"75-ball"}`)
+	tk := createTicket(t, id)
+	cells := cellValues(tk)
+	if tk.Rows != 5 || tk.Cols != 5 || len(cells) != 25 {
+		t.Fatalf("invalid ticket shape rows=%v cols=%v cells=%v", tk.Rows, tk.Cols, len(cells))
+	}
+	for i, n := range cells {
+		if i == 12 {
+			if n != ticket.FreeCell {
+				t.Errorf("centre is not free got=%v", n)
+			}
+			continue
+		}
+		c := int64(i % 5)
+		if n < c*15+1 || n > c*15+15 {
+			t.Errorf("number %v out of range of column %v", n, c)
+		}
+	}
+	startGame(t, id)
+	for i := 0; i < 75; i++ {
+		drawNumber(t, game.ID(id))
+	}
+	resp, _ := http.DefaultClient.Do(hostRequest(http.MethodGet, id, "/number/random"))
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("invalid status code after all 75 numbers drawn: want=400 got=%v", resp.StatusCode)
+	}
+}
+
+func TestCustomFormat(t *testing.T) {
+	id := createGame(t, `{"format":"custom","rows":2,"cols":4,"min":10,"max":29,"numbers":6}`)
+	tk := createTicket(t, id)
+	count := 0
+	for _, n := range cellValues(tk) {
+		if n == -1 {
+			continue
+		}
+		count++
+		if n < 10 || n > 29 {
+			t.Errorf("number %v out of range [10,29]", n)
+		}
+	}
+	if count != 6 {
+		t.Errorf("invalid numbers on ticket: want=6 got=%v", count)
+	}
+
+	for _, body := range []string{
+		`{"format":"custom","rows":2,"cols":4,"min":10,"max":12,"numbers":6}`,
+		`{"format":"custom","rows":0,"cols":4,"min":1,"max":90,"numbers":6}`,
+		`{"format":"80-ball"}`,
+	} {
+		resp, _ := http.Post(srvURL+"/api/game/create", "application/json", strings.NewReader(body))
+		if resp.StatusCode != http.StatusBadRequest {
+			t.Errorf("invalid status code for body=%v: want=400 got=%v", body, resp.StatusCode)
+		}
+	}
+}
+
+func TestTicketStrip(t *testing.T) {
+	id := createGame(t, `{"format":"90-ball"}`)
+	for i := 0; i < 20; i++ {
+		req, _ := http.NewRequest(http.MethodPost, srvURL+"/api/game/"+id+"/strip/testUser/generate", nil)
+		resp, _ := http.DefaultClient.Do(req)
+		if resp.StatusCode != 200 {
+			t.Fatalf("invalid status code: want=200 got=%v", resp.StatusCode)
+		}
+		strip := struct {
+			StripID   string      `json:"strip_id,omitempty"`
+			TicketIDs []ticket.ID `json:"ticket_ids,omitempty"`
+		}{}
+		json.NewDecoder(resp.Body).Decode(&strip)
+		if len(strip.TicketIDs) != 6 {
+			t.Fatalf("invalid tickets in strip: want=6 got=%v", len(strip.TicketIDs))
+		}
+		seen := make(map[int64]bool)
+		for _, ticketID := range strip.TicketIDs {
+			tk, _ := tickets.Find(context.Background(), ticketID)
+			if string(tk.StripID) != strip.StripID {
+				t.Errorf("ticket not linked to strip: want=%v got=%v", strip.StripID, tk.StripID)
+			}
+			cells := cellValues(tk)
+			for r := 0; r < 3; r++ {
+				count := 0
+				for c := 0; c < 9; c++ {
+					if cells[r*9+c] != -1 {
+						count++
+					}
+				}
+				if count != 5 {
+					t.Errorf("invalid numbers in row %v: want=5 got=%v cells=%v", r, count, cells)
+				}
+			}
+			for c := 0; c < 9; c++ {
+				if cells[c] == -1 && cells[9+c] == -1 && cells[18+c] == -1 {
+					t.Errorf("empty column %v cells=%v", c, cells)
+				}
+			}
+			for _, n := range cells {
+				if n == -1 {
+					continue
+				}
+				if seen[n] {
+					t.Errorf("number %v repeated in strip", n)
+				}
+				seen[n] = true
+			}
+		}
+		if len(seen) != 90 {
+			t.Errorf("invalid numbers in strip: want=90 got=%v", len(seen))
+		}
+	}
+	g, _ := games.Find(context.Background(), game.ID(id))
+	if len(g.Tickets) != 20*6 {
+		t.Errorf("invalid tickets in game: want=%v got=%v", 20*6, len(g.Tickets))
+	}
+
+	classic := createGame(t, ``)
+	req, _ := http.NewRequest(http.MethodPost, srvURL+"/api/game/"+classic+"/strip/testUser/generate", nil)
+	resp, _ := http.DefaultClient.Do(req)
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("invalid status code for classic game: want=400 got=%v", resp.StatusCode)
+	}
+}
@@ -0,0 +1,131 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/divyanshgaba/bingo/bingo"
+	"github.com/divyanshgaba/bingo/errs"
+	"github.com/divyanshgaba/bingo/pb"
+	"github.com/go-kit/kit/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// grpcClient serves the bingo service over gRPC on a local port, and returns a client for it.
+func grpcClient(t *testing.T) (pb.BingoClient, func()) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("could not listen err=%v", err)
+	}
+	bs := bingo.NewValidatingService(bingo.NewService(games, tickets, transactor, bingo.NewSeededSource(1)))
+	srv := grpc.NewServer()
+	pb.RegisterBingoServer(srv, bingo.MakeGRPCServer(bs, accounts, log.NewNopLogger()))
+	go srv.Serve(lis)
+	conn, err := grpc.Dial(lis.Addr().String(), grpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("could not dial err=%v", err)
+	}
+	return pb.NewBingoClient(conn), func() {
+		conn.Close()
+		srv.Stop()
+	}
+}
+
+func TestGRPC(t *testing.T) {
+	client, stop := grpcClient(t)
+	defer stop()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	clientFor("testUser")
+	token, _, _ := accounts.Login(ctx, "testUser", password)
+	player := metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+token)
+	created, err := client.CreateGame(player, &pb.CreateGameRequest{Format: "90-ball"})
+	if err != nil {
+		t.Fatalf("could not create game err=%v", err)
+	}
+	_, err = client.CreateTicket(ctx, &pb.CreateTicketRequest{GameId: created.GameId, Username: "testUser"})
+	if status.Code(err) != codes.Unauthenticated {
+		t.Errorf("invalid code without session token: want=%v got=%v", codes.Unauthenticated, status.Code(err))
+	}
+	if _, err := client.CreateTicket(player, &pb.CreateTicketRequest{GameId: created.GameId, Username: "testUser"}); err != nil {
+		t.Fatalf("could not create ticket err=%v", err)
+	}
+
+	stream, err := client.WatchGame(ctx, &pb.WatchGameRequest{GameId: created.GameId})
+	if err != nil {
+		t.Fatalf("could not watch game err=%v", err)
+	}
+	_, err = client.GenerateNumber(ctx, &pb.GenerateNumberRequest{GameId: created.GameId})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf("invalid code without host secret: want=%v got=%v", codes.PermissionDenied, status.Code(err))
+	}
+	host := metadata.AppendToOutgoingContext(ctx, bingo.HostSecretMetadata, created.HostSecret)
+	if _, err := client.StartGame(host, &pb.GameRequest{GameId: created.GameId}); err != nil {
+		t.Fatalf("could not start game err=%v", err)
+	}
+	drawn, err := client.GenerateNumber(host, &pb.GenerateNumberRequest{GameId: created.GameId})
+	if err != nil {
+		t.Fatalf("could not draw number err=%v", err)
+	}
+	if drawn.Seq != 1 || drawn.Announcement == "" {
+		t.Errorf("invalid draw: seq=%v announcement=%q", drawn.Seq, drawn.Announcement)
+	}
+	for {
+		e, err := stream.Recv()
+		if err != nil {
+			t.Fatalf("could not receive event err=%v", err)
+		}
+		if e.Type != string(bingo.EventNumberDrawn) {
+			continue
+		}
+		if e.Id != 1 || e.Number != drawn.Number {
+			t.Errorf("invalid event: want=%v,%v got=%v,%v", 1, drawn.Number, e.Id, e.Number)
+		}
+		break
+	}
+
+	draws, err := client.GetDraws(ctx, &pb.GetDrawsRequest{GameId: created.GameId})
+	if err != nil {
+		t.Fatalf("could not get draws err=%v", err)
+	}
+	if len(draws.Draws) != 1 || draws.Draws[0].Number != drawn.Number || draws.Draws[0].DrawnAt == nil {
+		t.Errorf("invalid draws: %v", draws.Draws)
+	}
+	stats, err := client.GetStats(ctx, &pb.GameRequest{GameId: created.GameId})
+	if err != nil {
+		t.Fatalf("could not get stats err=%v", err)
+	}
+	if stats.NumbersDrawn != 1 || stats.TicketsGenerated != 1 {
+		t.Errorf("invalid stats: numbers drawn=%v tickets=%v", stats.NumbersDrawn, stats.TicketsGenerated)
+	}
+	_, err = client.GetStats(ctx, &pb.GameRequest{GameId: "not-a-game"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("invalid code for unknown game: want=%v got=%v", codes.InvalidArgument, status.Code(err))
+	}
+	var invalid *errs.Error
+	if err := bingo.DecodeGRPCError(err); !errors.Is(err, bingo.ErrInvalidArgument) || !errors.As(err, &invalid) || len(invalid.Fields) != 1 {
+		t.Errorf("invalid decoded error for unknown game: want=%v got=%#v", bingo.ErrInvalidArgument, err)
+	}
+
+	// errors served with the same code are told apart by their error code
+	_, err = client.CreateTicket(player, &pb.CreateTicketRequest{GameId: created.GameId, Username: "testUser"})
+	if status.Code(err) != codes.FailedPrecondition || bingo.DecodeGRPCError(err) != bingo.ErrTicketSalesClosed {
+		t.Errorf("invalid error for ticket of running game: want=%v got=%v", bingo.ErrTicketSalesClosed, err)
+	}
+	limited, err := client.CreateGame(player, &pb.CreateGameRequest{MaxTickets: 1})
+	if err != nil {
+		t.Fatalf("could not create game err=%v", err)
+	}
+	client.CreateTicket(player, &pb.CreateTicketRequest{GameId: limited.GameId, Username: "testUser"})
+	_, err = client.CreateTicket(player, &pb.CreateTicketRequest{GameId: limited.GameId, Username: "testUser"})
+	if status.Code(err) != codes.FailedPrecondition || bingo.DecodeGRPCError(err) != bingo.ErrGameSoldOut {
+		t.Errorf("invalid error for ticket of sold out game: want=%v got=%v", bingo.ErrGameSoldOut, err)
+	}
+}
@@ -0,0 +1,99 @@
+package tests
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/divyanshgaba/bingo/game"
+	"github.com/divyanshgaba/bingo/ticket"
+)
+
+func TestMarkNumber(t *testing.T) {
+	id := createGame(t, "")
+	tk := createTicket(t, id)
+	startGame(t, id)
+	ticketURL := srvURL + "/api/ticket/" + string(tk.ID)
+
+	onTicket := map[int64]bool{}
+	for _, c := range cellValues(tk) {
+		if c != ticket.EmptyCell {
+			onTicket[c] = true
+		}
+	}
+	var number, offTicket int64 = -1, -1
+	for n := int64(0); n < 100; n++ {
+		if onTicket[n] && number == -1 {
+			number = n
+		}
+		if !onTicket[n] && offTicket == -1 {
+			offTicket = n
+		}
+	}
+
+	resp, _ := http.Post(ticketURL+"/mark/"+strconv.FormatInt(number, 10), "application/json", nil)
+	if resp.StatusCode != http.StatusUnprocessableEntity {
+		t.Errorf("invalid status code for number not drawn: want=422 got=%v", resp.StatusCode)
+	}
+	for i := 0; i < 100; i++ {
+		drawNumber(t, game.ID(id))
+	}
+	resp, _ = http.Post(ticketURL+"/mark/"+strconv.FormatInt(offTicket, 10), "application/json", nil)
+	if resp.StatusCode != http.StatusUnprocessableEntity {
+		t.Errorf("invalid status code for number not on ticket: want=422 got=%v", resp.StatusCode)
+	}
+	resp, _ = http.Post(ticketURL+"/mark/abc", "application/json", nil)
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("invalid status code for bad number: want=400 got=%v", resp.StatusCode)
+	}
+	resp, _ = http.Post(ticketURL+"/mark/"+strconv.FormatInt(number, 10), "application/json", nil)
+	if resp.StatusCode != 200 {
+		t.Errorf("invalid status code: want=200 got=%v", resp.StatusCode)
+	}
+	stored, _ := tickets.Find(context.Background(), tk.ID)
+	if len(stored.Marked) != 1 || stored.Marked[0] != number {
+		t.Errorf("invalid marked numbers: want=[%v] got=%v", number, stored.Marked)
+	}
+
+	resp, _ = http.Get(srvURL + "/ticket/" + string(tk.ID))
+	body := readBody(resp)
+	if !strings.Contains(body, "#ffd54f;'>"+strconv.FormatInt(number, 10)+"<") {
+		t.Errorf("marked number %v not highlighted in %v", number, body)
+	}
+
+	resp, _ = http.Post(ticketURL+"/unmark/"+strconv.FormatInt(number, 10), "application/json", nil)
+	if resp.StatusCode != 200 {
+		t.Errorf("invalid status code for unmark: want=200 got=%v", resp.StatusCode)
+	}
+	stored, _ = tickets.Find(context.Background(), tk.ID)
+	if len(stored.Marked) != 0 {
+		t.Errorf("number still marked after unmark: %v", stored.Marked)
+	}
+}
+
+func TestAutoDaub(t *testing.T) {
+	id := createGame(t, "")
+	tk := createTicket(t, id)
+	startGame(t, id)
+	resp, _ := http.Post(srvURL+"/api/ticket/"+string(tk.ID)+"/autodaub", "application/json", strings.NewReader(`{"enabled":true}`))
+	if resp.StatusCode != 200 {
+		t.Errorf("invalid status code: want=200 got=%v", resp.StatusCode)
+	}
+	for i := 0; i < 100; i++ {
+		drawNumber(t, game.ID(id))
+	}
+	resp, _ = http.Get(srvURL + "/ticket/" + string(tk.ID))
+	body := readBody(resp)
+	if n := strings.Count(body, "#ffd54f"); n != 15 {
+		t.Errorf("invalid count of daubed cells: want=15 got=%v", n)
+	}
+}
+
+func readBody(resp *http.Response) string {
+	defer resp.Body.Close()
+	b, _ := ioutil.ReadAll(resp.Body)
+	return string(b)
+}
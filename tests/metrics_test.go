@@ -0,0 +1,65 @@
+package tests
+
+import (
+	"bufio"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// scrapeMetrics returns values of the metrics served at /metrics, by name and labels as exposed.
+func scrapeMetrics(t *testing.T) map[string]float64 {
+	resp, err := http.Get(srvURL + "/metrics")
+	if err != nil || resp.StatusCode != 200 {
+		t.Fatalf("could not scrape metrics err=%v", err)
+	}
+	defer resp.Body.Close()
+	values := map[string]float64{}
+	sc := bufio.NewScanner(resp.Body)
+	for sc.Scan() {
+		line := sc.Text()
+		if strings.HasPrefix(line, "#") {
+			continue
+		}
+		i := strings.LastIndex(line, " ")
+		if i < 0 {
+			continue
+		}
+		v, err := strconv.ParseFloat(line[i+1:], 64)
+		if err == nil {
+			values[line[:i]] = v
+		}
+	}
+	return values
+}
+
+func TestMetrics(t *testing.T) {
+	before := scrapeMetrics(t)
+	id := createGame(t, `{"format":"90-ball"}`)
+	createTicket(t, id)
+	resp, err := http.Post(srvURL+"/api/game/"+id+"/strip/testUser/generate", "", nil)
+	if err != nil || resp.StatusCode != 200 {
+		t.Fatalf("could not create strip err=%v", err)
+	}
+	resp.Body.Close()
+	after := scrapeMetrics(t)
+
+	tests := []struct {
+		name  string
+		delta float64
+	}{
+		{"bingo_game_games_created_total", 1},
+		{"bingo_game_tickets_issued_total", 7},
+		{"bingo_game_active_games", 1},
+		{`bingo_service_requests_total{method="create_game"}`, 1},
+		{`bingo_service_request_duration_seconds_count{method="create_ticket_strip"}`, 1},
+		{`bingo_mongo_request_duration_seconds_count{method="insert",repository="games"}`, 1},
+		{`bingo_mongo_request_duration_seconds_count{method="within_transaction",repository="transactions"}`, 2},
+	}
+	for _, tc := range tests {
+		if got := after[tc.name] - before[tc.name]; got != tc.delta {
+			t.Errorf("invalid change of %v: want=%v got=%v", tc.name, tc.delta, got)
+		}
+	}
+}
@@ -0,0 +1,109 @@
+package tests
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/divyanshgaba/bingo/bingo"
+	"github.com/divyanshgaba/bingo/client"
+	"github.com/divyanshgaba/bingo/game"
+	"github.com/divyanshgaba/bingo/inmem"
+	"github.com/divyanshgaba/bingo/player"
+	"github.com/go-kit/kit/log"
+)
+
+func TestCommitReveal(t *testing.T) {
+	ctx := player.NewContext(context.Background(), "testuser")
+	games, tickets := inmem.NewGameRepository(), inmem.NewTicketRepository()
+	bs := bingo.NewService(games, tickets, inmem.NewTransactor(games, tickets), bingo.NewCommitRevealSource())
+
+	id, _, err := bs.CreateGame(ctx, game.Format{Name: game.Format90Ball}, game.Rules{})
+	if err != nil {
+		t.Fatalf("could not create game err=%v", err)
+	}
+	ticketID, _ := bs.CreateTicket(ctx, id, "testuser")
+	_, stripIDs, _ := bs.CreateTicketStrip(ctx, id, "testuser")
+
+	seedHash, seed, err := bs.GetSeed(ctx, id)
+	if err != bingo.ErrSeedNotRevealed || seedHash == "" || seed != "" {
+		t.Errorf("only seed hash should be published before game finishes hash=%v seed=%v err=%v", seedHash, seed, err)
+	}
+	srv := httptest.NewServer(bingo.MakeHandler(bs, accounts, log.NewNopLogger()))
+	defer srv.Close()
+	remote, _ := client.New(srv.URL)
+	if hash, _, err := remote.GetSeed(ctx, id); err != bingo.ErrSeedNotRevealed || hash != seedHash {
+		t.Errorf("only seed hash should be served before game finishes hash=%v err=%v", hash, err)
+	}
+	if tk, err := remote.ShowTicket(ctx, ticketID); err != nil || tk.Nonce != "" || tk.Format != nil {
+		t.Errorf("nonce of ticket should not be served before game finishes ticket=%+v err=%v", tk, err)
+	}
+	if err := bs.StartGame(ctx, id); err != nil {
+		t.Fatalf("could not start game err=%v", err)
+	}
+	for i := 0; i < 90; i++ {
+		if _, err := bs.GenerateNumber(ctx, id, ""); err != nil {
+			t.Fatalf("could not draw number err=%v", err)
+		}
+	}
+	_, seed, err = bs.GetSeed(ctx, id)
+	if err != nil || seed == "" {
+		t.Fatalf("seed not revealed after game finished err=%v", err)
+	}
+	if _, served, err := remote.GetSeed(ctx, id); err != nil || served != seed {
+		t.Errorf("seed not served after game finished err=%v", err)
+	}
+
+	g, _ := games.Find(ctx, id)
+	if !bingo.VerifyDraws(seed, seedHash, g.Format, g.Numbers) {
+		t.Errorf("draws could not be verified with revealed seed")
+	}
+	for _, tid := range append(stripIDs, ticketID) {
+		tk, err := remote.ShowTicket(ctx, tid)
+		if err != nil {
+			t.Fatalf("could not show ticket err=%v", err)
+		}
+		if !tk.Verify(seed, seedHash) {
+			t.Errorf("ticket %v could not be verified with revealed seed", tid)
+		}
+		tk.Nonce = "0" + tk.Nonce
+		if tk.Verify(seed, seedHash) {
+			t.Errorf("ticket %v with another nonce should not verify", tid)
+		}
+	}
+	g.Numbers[0], g.Numbers[1] = g.Numbers[1], g.Numbers[0]
+	if bingo.VerifyDraws(seed, seedHash, g.Format, g.Numbers) {
+		t.Errorf("draws in different order should not verify")
+	}
+	if bingo.VerifyDraws("not the seed", seedHash, g.Format, nil) {
+		t.Errorf("seed not matching hash should not verify")
+	}
+}
+
+func TestSeededSource(t *testing.T) {
+	ctx := player.NewContext(context.Background(), "testUser")
+	play := func() (string, []int64) {
+		games, tickets := inmem.NewGameRepository(), inmem.NewTicketRepository()
+		bs := bingo.NewService(games, tickets, inmem.NewTransactor(games, tickets), bingo.NewSeededSource(42))
+		id, _, _ := bs.CreateGame(ctx, game.Format{}, game.Rules{})
+		ticketID, _ := bs.CreateTicket(ctx, id, "testUser")
+		bs.StartGame(ctx, id)
+		for i := 0; i < 10; i++ {
+			bs.GenerateNumber(ctx, id, "")
+		}
+		tk, _ := tickets.Find(ctx, ticketID)
+		g, _ := games.Find(ctx, id)
+		return tk.Cells, g.Numbers
+	}
+	cells1, numbers1 := play()
+	cells2, numbers2 := play()
+	if cells1 != cells2 {
+		t.Errorf("tickets differ for same seed: %v != %v", cells1, cells2)
+	}
+	for i := range numbers1 {
+		if numbers1[i] != numbers2[i] {
+			t.Errorf("draws differ for same seed: %v != %v", numbers1, numbers2)
+			break
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package tests
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestTicketRules(t *testing.T) {
+	id := createGame(t, `{"format":"90-ball","max_tickets_per_user":2,"max_tickets":7}`)
+	gameURL := srvURL + "/api/game/" + id
+	steps := []struct {
+		username, path string
+		want           int
+	}{
+		{"bob", "/strip/bob/generate", http.StatusUnprocessableEntity}, // 6 tickets over bob's limit of 2
+		{"alice", "/ticket/alice/generate", http.StatusOK},
+		{"alice", "/ticket/alice/generate", http.StatusOK},
+		{"alice", "/ticket/alice/generate", http.StatusUnprocessableEntity},
+		{"bob", "/ticket/bob/generate", http.StatusOK},
+		{"carol", "/ticket/carol/generate", http.StatusOK},
+		{"carol", "/ticket/carol/generate", http.StatusOK},
+		{"dave", "/ticket/dave/generate", http.StatusOK},
+		{"erin", "/ticket/erin/generate", http.StatusOK},
+		{"frank", "/ticket/frank/generate", http.StatusConflict},
+	}
+	for i, step := range steps {
+		resp, _ := clientFor(step.username).Post(gameURL+step.path, "application/json", nil)
+		if resp.StatusCode != step.want {
+			t.Errorf("invalid status code for step %v %v: want=%v got=%v", i, step.path, step.want, resp.StatusCode)
+		}
+	}
+}
+
+func TestSalesCutoff(t *testing.T) {
+	id := createGame(t, `{"sales_cutoff":"first_draw"}`)
+	steps := []struct {
+		method, path string
+		want         int
+	}{
+		{http.MethodPost, "/start", http.StatusOK},
+		{http.MethodPost, "/ticket/testUser/generate", http.StatusOK},
+		{http.MethodGet, "/number/random", http.StatusOK},
+		{http.MethodPost, "/ticket/testUser/generate", http.StatusConflict},
+	}
+	for _, step := range steps {
+		resp, _ := http.DefaultClient.Do(hostRequest(step.method, id, step.path))
+		if resp.StatusCode != step.want {
+			t.Errorf("invalid status code for %v %v: want=%v got=%v", step.method, step.path, step.want, resp.StatusCode)
+		}
+	}
+
+	resp, _ := http.Post(srvURL+"/api/game/create", "application/json", strings.NewReader(`{"sales_cutoff":"never"}`))
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("invalid status code for unknown cutoff: want=400 got=%v", resp.StatusCode)
+	}
+}
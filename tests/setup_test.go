@@ -2,61 +2,163 @@ package tests
 
 import (
 	"context"
-	"fmt"
+	"flag"
 	"os"
+	"sync"
 	"testing"
+	"time"
 
 	"net/http"
 	"net/http/httptest"
 
+	"github.com/divyanshgaba/bingo/account"
 	"github.com/divyanshgaba/bingo/bingo"
 	"github.com/divyanshgaba/bingo/config"
 	"github.com/divyanshgaba/bingo/game"
+	"github.com/divyanshgaba/bingo/inmem"
 	"github.com/divyanshgaba/bingo/mongo"
+	"github.com/divyanshgaba/bingo/player"
 	"github.com/divyanshgaba/bingo/ticket"
 	"github.com/go-kit/kit/log"
+	kitprometheus "github.com/go-kit/kit/metrics/prometheus"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"go.mongodb.org/mongo-driver/bson"
 )
 
 var (
-	srvURL   string
-	gameID   game.ID
-	ticketID ticket.ID
-	games    game.Repository
-	tickets  ticket.Repository
+	srvURL     string
+	gameID     game.ID
+	ticketID   ticket.ID
+	games      game.Repository
+	tickets    ticket.Repository
+	transactor game.Transactor
+	players    player.Repository
+	accounts   account.Service
 )
 
+// TestMain runs the suite against an in-memory store, or against mongo when -env=test is passed.
 func TestMain(m *testing.M) {
-	if config.Env() != "test" {
-		fmt.Println("pass -env=test to enable testing")
-		os.Exit(1)
-	}
+	flag.Parse()
 	// setup
 	var logger log.Logger
 	logger = log.NewLogfmtLogger(log.NewSyncWriter(os.Stderr))
 	logger = log.With(logger, "ts", log.DefaultTimestampUTC)
 
-	mongoClient, err := mongo.NewClient(logger)
-	if err != nil {
-		panic("could not create mongo client")
+	if config.Env() == "test" {
+		mongoClient, err := mongo.NewClient(logger)
+		if err != nil {
+			panic("could not create mongo client")
+		}
+		// the replica set may still be initiating when the suite starts with docker-compose.test.yml
+		err = mongo.CheckTransactions(context.Background(), mongoClient)
+		for i := 0; err == mongo.ErrTransactionsUnsupported && i < 30; i++ {
+			time.Sleep(2 * time.Second)
+			err = mongo.CheckTransactions(context.Background(), mongoClient)
+		}
+		if err != nil {
+			panic(err)
+		}
+		if err := mongo.Migrate(context.Background(), mongoClient); err != nil {
+			panic(err)
+		}
+		games = mongo.NewGameRepository(mongoClient)
+		tickets = mongo.NewTicketRepository(mongoClient)
+		transactor = mongo.NewTransactor(mongoClient)
+		players = mongo.NewPlayerRepository(mongoClient)
+		// setup database
+		Database()
+	} else {
+		games = inmem.NewGameRepository()
+		tickets = inmem.NewTicketRepository()
+		transactor = inmem.NewTransactor(games, tickets)
+		players = inmem.NewPlayerRepository()
 	}
-	games = mongo.NewGameRepository(mongoClient)
-	tickets = mongo.NewTicketRepository(mongoClient)
+	// repositories are instrumented like they are in main
+	storeLatency := kitprometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+		Namespace: "bingo", Subsystem: "mongo", Name: "request_duration_seconds",
+	}, []string{"repository", "method"})
+	games = game.NewInstrumentingRepository(storeLatency, games)
+	tickets = ticket.NewInstrumentingRepository(storeLatency, tickets)
+	transactor = game.NewInstrumentingTransactor(storeLatency, transactor)
 	var bs bingo.Service
-	bs = bingo.NewService(games, tickets)
+	bs = bingo.NewService(games, tickets, transactor, bingo.NewSeededSource(1), bingo.WithMetrics(bingo.Metrics{
+		GamesCreated:  kitprometheus.NewCounterFrom(stdprometheus.CounterOpts{Namespace: "bingo", Subsystem: "game", Name: "games_created_total"}, nil),
+		TicketsIssued: kitprometheus.NewCounterFrom(stdprometheus.CounterOpts{Namespace: "bingo", Subsystem: "game", Name: "tickets_issued_total"}, nil),
+		NumbersDrawn:  kitprometheus.NewCounterFrom(stdprometheus.CounterOpts{Namespace: "bingo", Subsystem: "game", Name: "numbers_drawn_total"}, nil),
+	}))
+	stdprometheus.MustRegister(stdprometheus.NewGaugeFunc(stdprometheus.GaugeOpts{Namespace: "bingo", Subsystem: "game", Name: "active_games"}, func() float64 {
+		n, _ := games.CountActive(context.Background())
+		return float64(n)
+	}))
+	bs = bingo.NewValidatingService(bs)
+	bs = bingo.NewInstrumentingService(
+		kitprometheus.NewCounterFrom(stdprometheus.CounterOpts{Namespace: "bingo", Subsystem: "service", Name: "requests_total"}, []string{"method"}),
+		kitprometheus.NewHistogramFrom(stdprometheus.HistogramOpts{Namespace: "bingo", Subsystem: "service", Name: "request_duration_seconds"}, []string{"method"}),
+		bs,
+	)
+	accounts = account.NewService(players)
 	httpLogger := log.With(logger, "component", "http-test")
 
 	mux := http.NewServeMux()
-	mux.Handle("/", bingo.MakeHandler(bs, httpLogger))
+	mux.Handle("/api/player/", account.MakeHandler(accounts, httpLogger))
+	mux.Handle("/", bingo.MakeHandler(bs, accounts, httpLogger))
+	mux.Handle("/metrics", promhttp.Handler())
 	// run server
 	srv := httptest.NewServer(mux)
 	defer srv.Close()
-	// setup database
-	Database()
+	gameID, _ = games.Insert(context.Background(), game.Game{Host: "testuser", Status: game.Lobby})
 	srvURL = srv.URL
+	// requests made with the default client act as testUser
+	http.DefaultClient = clientFor("testUser")
 	os.Exit(m.Run())
 }
 
+var (
+	clientsMtx sync.Mutex
+	clients    = map[string]*http.Client{}
+)
+
+// clientFor returns a client whose requests are authenticated as player username, registering the player if needed.
+func clientFor(username string) *http.Client {
+	clientsMtx.Lock()
+	defer clientsMtx.Unlock()
+	if c, ok := clients[username]; ok {
+		return c
+	}
+	ctx := context.Background()
+	if err := accounts.Register(ctx, username, password); err != nil && err != player.ErrUsernameTaken {
+		panic(err)
+	}
+	token, _, err := accounts.Login(ctx, username, password)
+	if err != nil {
+		panic(err)
+	}
+	clients[username] = &http.Client{Transport: bearer{token: token}}
+	return clients[username]
+}
+
+// password of players in tests
+const password = "correct horse"
+
+// bearer authenticates requests with a session token, unless they already carry one.
+type bearer struct {
+	token string
+}
+
+func (b bearer) RoundTrip(r *http.Request) (*http.Response, error) {
+	if r.Header.Get("Authorization") == "" {
+		authorized := *r
+		authorized.Header = make(http.Header, len(r.Header)+1)
+		for k, v := range r.Header {
+			authorized.Header[k] = v
+		}
+		authorized.Header.Set("Authorization", "Bearer "+b.token)
+		r = &authorized
+	}
+	return http.DefaultTransport.RoundTrip(r)
+}
+
 func Database() {
 	var logger log.Logger
 	logger = log.NewLogfmtLogger(log.NewSyncWriter(os.Stderr))
@@ -69,5 +171,6 @@ func Database() {
 	// clean db
 	db.Collection("games").DeleteMany(context.Background(), bson.D{})
 	db.Collection("tickets").DeleteMany(context.Background(), bson.D{})
-	gameID, _ = games.Insert(context.Background(), game.Game{})
+	db.Collection("players").DeleteMany(context.Background(), bson.D{})
+	db.Collection("sessions").DeleteMany(context.Background(), bson.D{})
 }
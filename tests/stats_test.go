@@ -0,0 +1,87 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/divyanshgaba/bingo/bingo"
+	"github.com/divyanshgaba/bingo/game"
+	"github.com/divyanshgaba/bingo/player"
+)
+
+func TestGameStats(t *testing.T) {
+	id := createGame(t, `{"format":"75-ball"}`)
+	createTicket(t, id)
+	resp, _ := clientFor("alice").Post(srvURL+"/api/game/"+id+"/ticket/alice/generate", "application/json", nil)
+	if resp.StatusCode != 200 {
+		t.Fatalf("invalid status code: want=200 got=%v", resp.StatusCode)
+	}
+	startGame(t, id)
+	drawNumber(t, game.ID(id))
+	drawNumber(t, game.ID(id))
+
+	resp, _ = http.Get(srvURL + "/api/game/" + id + "/stats")
+	if resp.StatusCode != 200 {
+		t.Fatalf("invalid status code: want=200 got=%v", resp.StatusCode)
+	}
+	var stats bingo.Stats
+	if err := json.NewDecoder(resp.Body).Decode(&stats); err != nil {
+		t.Fatalf("error while decoding response body err=%v", err)
+	}
+	if stats.NumbersDrawn != 2 || stats.NumbersRemaining != 73 {
+		t.Errorf("invalid numbers drawn and remaining: want=2,73 got=%v,%v", stats.NumbersDrawn, stats.NumbersRemaining)
+	}
+	if stats.TicketsGenerated != 2 || stats.Players != 2 {
+		t.Errorf("invalid tickets and players: want=2,2 got=%v,%v", stats.TicketsGenerated, stats.Players)
+	}
+	if stats.FirstDrawnAt == nil || stats.LastDrawnAt == nil || stats.LastDrawnAt.Before(*stats.FirstDrawnAt) {
+		t.Errorf("invalid draw timestamps: first=%v last=%v", stats.FirstDrawnAt, stats.LastDrawnAt)
+	}
+	if len(stats.Prizes) != len(game.Prizes) {
+		t.Errorf("invalid count of prizes: want=%v got=%v", len(game.Prizes), len(stats.Prizes))
+	}
+	for _, p := range stats.Prizes {
+		if p.Claimed {
+			t.Errorf("prize %v claimed", p.Prize)
+		}
+	}
+	if len(stats.Leaderboard) != 2 {
+		t.Fatalf("invalid leaderboard length: want=2 got=%v", len(stats.Leaderboard))
+	}
+	first, second := stats.Leaderboard[0], stats.Leaderboard[1]
+	if first.FullHouseAway > second.FullHouseAway {
+		t.Errorf("leaderboard not in order: %v before %v", first, second)
+	}
+	for _, s := range stats.Leaderboard {
+		// 24 numbers on a 75-ball card, lines of 5 with a free centre in the middle line
+		if s.FullHouseAway < 22 || s.FullHouseAway > 24 || s.LineAway > 5 {
+			t.Errorf("invalid numbers away for ticket %v: full house=%v line=%v", s.TicketID, s.FullHouseAway, s.LineAway)
+		}
+	}
+}
+
+func TestStatsCache(t *testing.T) {
+	ctx := player.NewContext(context.Background(), "testUser")
+	bs := bingo.NewService(games, tickets, transactor, bingo.NewSeededSource(1), bingo.WithStatsCache(1))
+	id, _, _ := bs.CreateGame(ctx, game.Format{}, game.Rules{})
+	if _, err := bs.CreateTicket(ctx, id, "testUser"); err != nil {
+		t.Fatalf("could not create ticket err=%v", err)
+	}
+	if err := bs.StartGame(ctx, id); err != nil {
+		t.Fatalf("could not start game err=%v", err)
+	}
+	for want := 0; want < 3; want++ {
+		stats, err := bs.GetStats(ctx, id)
+		if err != nil {
+			t.Fatalf("could not get stats err=%v", err)
+		}
+		if stats.NumbersDrawn != want || stats.NumbersRemaining != 100-want {
+			t.Errorf("stale stats: want=%v drawn got=%v", want, stats.NumbersDrawn)
+		}
+		if _, err := bs.GenerateNumber(ctx, id, ""); err != nil {
+			t.Fatalf("could not draw number err=%v", err)
+		}
+	}
+}
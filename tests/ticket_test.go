@@ -0,0 +1,164 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/divyanshgaba/bingo/game"
+)
+
+func TestShowTicketNegotiation(t *testing.T) {
+	tk := createTicket(t, createGame(t, ""))
+	tests := []struct {
+		accept, contentType string
+	}{
+		{"", "text/html"},
+		{"text/html,application/xhtml+xml,*/*;q=0.8", "text/html"},
+		{"application/json", "application/json"},
+		{"text/plain", "text/plain"},
+		{"text/html;q=0.5, text/plain", "text/plain"},
+		{"image/png", "text/html"},
+	}
+	for _, tc := range tests {
+		req, _ := http.NewRequest(http.MethodGet, srvURL+"/ticket/"+string(tk.ID), nil)
+		req.Header.Set("Accept", tc.accept)
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil || resp.StatusCode != 200 {
+			t.Fatalf("could not show ticket err=%v", err)
+		}
+		if ct := resp.Header.Get("Content-Type"); !strings.HasPrefix(ct, tc.contentType) {
+			t.Errorf("invalid content type for Accept=%q: want=%v got=%v", tc.accept, tc.contentType, ct)
+		}
+		body := readBody(resp)
+		if tc.contentType == "text/plain" && strings.Count(body, "\n") != 7 {
+			t.Errorf("invalid text grid:\n%v", body)
+		}
+		if tc.contentType != "application/json" {
+			continue
+		}
+		var grid struct {
+			Rows [][]*int64 `json:"rows"`
+		}
+		json.NewDecoder(strings.NewReader(body)).Decode(&grid)
+		numbers := 0
+		for _, row := range grid.Rows {
+			for _, n := range row {
+				if n != nil {
+					numbers++
+				}
+			}
+		}
+		if len(grid.Rows) != 3 || len(grid.Rows[0]) != 9 || numbers != 15 {
+			t.Errorf("invalid JSON grid: %v", body)
+		}
+	}
+}
+
+func TestGetTicket(t *testing.T) {
+	id := createGame(t, "")
+	tk := createTicket(t, id)
+	startGame(t, id)
+	for i := 0; i < 100; i++ {
+		drawNumber(t, game.ID(id))
+	}
+	var number int64 = -1
+	for _, c := range cellValues(tk) {
+		if c != -1 {
+			number = c
+			break
+		}
+	}
+	http.Post(srvURL+"/api/ticket/"+string(tk.ID)+"/mark/"+strconv.FormatInt(number, 10), "application/json", nil)
+
+	resp, err := http.Get(srvURL + "/api/ticket/" + string(tk.ID))
+	if err != nil || resp.StatusCode != 200 {
+		t.Fatalf("could not get ticket err=%v", err)
+	}
+	var details struct {
+		ID       string `json:"id"`
+		GameID   string `json:"game_id"`
+		Username string `json:"username"`
+		Rows     [][]struct {
+			Number *int64 `json:"number"`
+			Drawn  bool   `json:"drawn"`
+			Marked bool   `json:"marked"`
+		} `json:"rows"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&details); err != nil {
+		t.Fatalf("error while decoding response body err=%v", err)
+	}
+	if details.ID != string(tk.ID) || details.GameID != id || details.Username != "testuser" {
+		t.Errorf("invalid ticket details: %+v", details)
+	}
+	drawn, marked := 0, 0
+	for _, row := range details.Rows {
+		for _, c := range row {
+			if c.Drawn {
+				drawn++
+			}
+			if c.Marked {
+				marked++
+				if c.Number == nil || *c.Number != number {
+					t.Errorf("invalid marked cell: want=%v got=%v", number, c.Number)
+				}
+			}
+		}
+	}
+	if drawn != 15 || marked != 1 {
+		t.Errorf("invalid cell state: want drawn=15 marked=1 got drawn=%v marked=%v", drawn, marked)
+	}
+
+	resp, _ = http.Get(srvURL + "/api/ticket/5efbbf88abaf8fdbd4aae78d")
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("invalid status code for unknown ticket: want=400 got=%v", resp.StatusCode)
+	}
+}
+
+func TestListTickets(t *testing.T) {
+	id := createGame(t, `{"format":"90-ball"}`)
+	created := map[string]bool{}
+	for i := 0; i < 3; i++ {
+		created[string(createTicket(t, id).ID)] = true
+	}
+	resp, _ := clientFor("stripUser").Post(srvURL+"/api/game/"+id+"/strip/stripUser/generate", "application/json", nil)
+	resp.Body.Close()
+
+	type page struct {
+		Tickets []struct {
+			TicketID  string    `json:"ticket_id"`
+			Username  string    `json:"username"`
+			StripID   string    `json:"strip_id"`
+			CreatedAt time.Time `json:"created_at"`
+		} `json:"tickets"`
+		Total int `json:"total"`
+	}
+	var first, second page
+	resp, _ = http.Get(srvURL + "/api/game/" + id + "/tickets?limit=4")
+	json.NewDecoder(resp.Body).Decode(&first)
+	resp, _ = http.Get(srvURL + "/api/game/" + id + "/tickets?offset=4&limit=4")
+	json.NewDecoder(resp.Body).Decode(&second)
+	if first.Total != 9 || len(first.Tickets) != 4 || len(second.Tickets) != 4 {
+		t.Fatalf("invalid pages: total=%v first=%v second=%v", first.Total, len(first.Tickets), len(second.Tickets))
+	}
+	for i, tk := range first.Tickets[:3] {
+		if !created[tk.TicketID] || tk.Username != "testuser" || tk.CreatedAt.IsZero() {
+			t.Errorf("invalid ticket %v in listing: %+v", i, tk)
+		}
+	}
+	if first.Tickets[3].Username != "stripuser" || first.Tickets[3].StripID == "" {
+		t.Errorf("invalid strip ticket in listing: %+v", first.Tickets[3])
+	}
+
+	resp, _ = http.Get(srvURL + "/api/game/" + id + "/tickets?limit=1000")
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("invalid status code for page too large: want=400 got=%v", resp.StatusCode)
+	}
+	resp, _ = http.Get(srvURL + "/api/game/5efbbb5c0880edb67dd9fd33/tickets")
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("invalid status code for unknown game: want=400 got=%v", resp.StatusCode)
+	}
+}
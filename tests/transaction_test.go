@@ -0,0 +1,115 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/divyanshgaba/bingo/bingo"
+	"github.com/divyanshgaba/bingo/game"
+	"github.com/divyanshgaba/bingo/inmem"
+	"github.com/divyanshgaba/bingo/player"
+	"github.com/divyanshgaba/bingo/ticket"
+)
+
+var errInjected = errors.New("injected failure")
+
+// failingGames fails to add tickets to games.
+type failingGames struct {
+	game.Repository
+}
+
+func (failingGames) AddTicket(context.Context, game.ID, ...ticket.ID) error {
+	return errInjected
+}
+
+// failingTransactor runs units of work with games which fail to add tickets, as a decorator of repositories
+// would, such as game.NewInstrumentingTransactor.
+type failingTransactor struct {
+	next game.Transactor
+}
+
+func (t failingTransactor) WithinTransaction(ctx context.Context, fn func(context.Context, game.Repository, ticket.Repository) error) error {
+	return t.next.WithinTransaction(ctx, func(ctx context.Context, games game.Repository, tickets ticket.Repository) error {
+		return fn(ctx, failingGames{games}, tickets)
+	})
+}
+
+// TestCreateTicketRollback runs on the stores of the suite, so with -env=test units of work run in mongo transactions.
+func TestCreateTicketRollback(t *testing.T) {
+	ctx := player.NewContext(context.Background(), "testUser")
+	bs := bingo.NewService(games, tickets, failingTransactor{transactor}, bingo.NewSeededSource(1))
+	id, _, _ := bs.CreateGame(ctx, game.Format{Name: game.Format90Ball}, game.Rules{})
+
+	if _, err := bs.CreateTicket(ctx, id, "testUser"); err != errInjected {
+		t.Errorf("invalid error for ticket: want=%v got=%v", errInjected, err)
+	}
+	if _, _, err := bs.CreateTicketStrip(ctx, id, "testUser"); err != errInjected {
+		t.Errorf("invalid error for strip: want=%v got=%v", errInjected, err)
+	}
+	orphans, total, _ := tickets.FindByGame(ctx, string(id), 0, 10)
+	if total != 0 {
+		t.Errorf("orphan tickets left after failed creation: %v", orphans)
+	}
+	g, _ := games.Find(ctx, id)
+	if len(g.Tickets) != 0 {
+		t.Errorf("tickets added to game after failed creation: %v", g.Tickets)
+	}
+}
+
+func TestTransactorNeedsStores(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("transactor made with decorated repositories, whose changes it could not undo")
+		}
+	}()
+	inmem.NewTransactor(failingGames{inmem.NewGameRepository()}, inmem.NewTicketRepository())
+}
+
+func TestTransactorRollback(t *testing.T) {
+	ctx := context.Background()
+	games, tickets := inmem.NewGameRepository(), inmem.NewTicketRepository()
+	tx := inmem.NewTransactor(games, tickets)
+	gameID, _ := games.Insert(ctx, game.Game{Status: game.Lobby})
+	ticketID, _ := tickets.Insert(ctx, ticket.Ticket{GameID: string(gameID), Username: "testUser"})
+
+	err := tx.WithinTransaction(ctx, func(ctx context.Context, games game.Repository, tickets ticket.Repository) error {
+		if err := games.SetStatus(ctx, gameID, game.Lobby, game.Running); err != nil {
+			return err
+		}
+		if err := games.AddNumber(ctx, gameID, game.Draw{Seq: 1, Number: 7}); err != nil {
+			return err
+		}
+		if err := tickets.Mark(ctx, ticketID, 7); err != nil {
+			return err
+		}
+		if err := tickets.SetAutoDaub(ctx, ticketID, true); err != nil {
+			return err
+		}
+		if _, err := tickets.Insert(ctx, ticket.Ticket{GameID: string(gameID), Username: "testUser"}); err != nil {
+			return err
+		}
+		return errInjected
+	})
+	if err != errInjected {
+		t.Errorf("invalid error: want=%v got=%v", errInjected, err)
+	}
+	g, _ := games.Find(ctx, gameID)
+	if g.Status != game.Lobby || len(g.Numbers) != 0 {
+		t.Errorf("game changed by failed unit of work: status=%v numbers=%v", g.Status, g.Numbers)
+	}
+	tk, _ := tickets.Find(ctx, ticketID)
+	if len(tk.Marked) != 0 || tk.AutoDaub {
+		t.Errorf("ticket changed by failed unit of work: %+v", tk)
+	}
+	if _, total, _ := tickets.FindByGame(ctx, string(gameID), 0, 10); total != 1 {
+		t.Errorf("invalid tickets after failed unit of work: want=1 got=%v", total)
+	}
+
+	err = tx.WithinTransaction(ctx, func(ctx context.Context, games game.Repository, tickets ticket.Repository) error {
+		return games.SetStatus(ctx, gameID, game.Lobby, game.Running)
+	})
+	if g, _ := games.Find(ctx, gameID); err != nil || g.Status != game.Running {
+		t.Errorf("changes of unit of work not kept status=%v err=%v", g.Status, err)
+	}
+}
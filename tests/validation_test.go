@@ -0,0 +1,105 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/divyanshgaba/bingo/errs"
+	"github.com/divyanshgaba/bingo/ticket"
+)
+
+func TestValidation(t *testing.T) {
+	id := createGame(t, "")
+	tests := []struct {
+		name, path string
+		field      string
+	}{
+		{"markup in username", "/api/game/" + id + "/ticket/" + url.PathEscape("<img src=x onerror=alert(1)>") + "/generate", "username"},
+		{"long username", "/api/game/" + id + "/ticket/" + strings.Repeat("a", 100) + "/generate", "username"},
+		{"blank username", "/api/game/" + id + "/ticket/%20%20/generate", "username"},
+		{"malformed game ID", "/api/game/" + id + "x/ticket/testUser/generate", "game_id"},
+		{"malformed ticket ID", "/api/ticket/not-a-ticket/autodaub", "ticket_id"},
+	}
+	for _, tc := range tests {
+		req, _ := http.NewRequest(http.MethodPost, srvURL+tc.path, strings.NewReader(`{"enabled":true}`))
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("could not request %v err=%v", tc.name, err)
+		}
+		var p errs.Problem
+		json.NewDecoder(resp.Body).Decode(&p)
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusBadRequest || p.Code != "bingo.invalid_argument" ||
+			len(p.InvalidParams) != 1 || p.InvalidParams[0].Name != tc.field || p.InvalidParams[0].Reason == "" {
+			t.Errorf("invalid problem details for %v: status=%v %+v", tc.name, resp.StatusCode, p)
+		}
+	}
+
+	// usernames are normalized, surrounding spaces are dropped
+	req, _ := http.NewRequest(http.MethodPost, srvURL+"/api/game/"+id+"/ticket/%20testUser%20/generate", nil)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil || resp.StatusCode != 200 {
+		t.Fatalf("could not create ticket for padded username err=%v", err)
+	}
+	resp.Body.Close()
+
+	resp, _ = http.Post(srvURL+"/api/player/register", "application/json",
+		strings.NewReader(`{"username":"ｆｕｌｌｗｉｄｔｈ","password":"`+password+`"}`))
+	var registered struct {
+		Username string `json:"username"`
+	}
+	json.NewDecoder(resp.Body).Decode(&registered)
+	resp.Body.Close()
+	if resp.StatusCode != 200 || registered.Username != "fullwidth" {
+		t.Errorf("invalid registration of full-width username: status=%v username=%v", resp.StatusCode, registered.Username)
+	}
+
+	// usernames differing only in case name the same player
+	resp, _ = http.Post(srvURL+"/api/player/register", "application/json",
+		strings.NewReader(`{"username":"CaseFold","password":"`+password+`"}`))
+	json.NewDecoder(resp.Body).Decode(&registered)
+	resp.Body.Close()
+	if resp.StatusCode != 200 || registered.Username != "casefold" {
+		t.Errorf("invalid registration of mixed case username: status=%v username=%v", resp.StatusCode, registered.Username)
+	}
+	resp, _ = http.Post(srvURL+"/api/player/register", "application/json",
+		strings.NewReader(`{"username":"cASEfOLD","password":"`+password+`"}`))
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusConflict {
+		t.Errorf("invalid status code for username taken in another case: want=409 got=%v", resp.StatusCode)
+	}
+	if _, _, err := accounts.Login(context.Background(), "CASEFOLD", password); err != nil {
+		t.Errorf("could not login in another case err=%v", err)
+	}
+	resp, _ = clientFor("casefold").Post(srvURL+"/api/game/"+id+"/ticket/CaseFold/generate", "application/json", nil)
+	if resp.StatusCode != 200 {
+		t.Errorf("invalid status code for ticket of username in another case: want=200 got=%v", resp.StatusCode)
+	}
+}
+
+func TestShowTicketEscapesUsername(t *testing.T) {
+	id := createGame(t, "")
+	// tickets bought before usernames were validated
+	ticketID, err := tickets.Insert(context.Background(), ticket.Ticket{
+		GameID:   id,
+		Username: "<script>alert(1)</script>",
+		Rows:     1,
+		Cols:     3,
+		Cells:    "1;2;3",
+	})
+	if err != nil {
+		t.Fatalf("could not insert ticket err=%v", err)
+	}
+	resp, err := http.Get(srvURL + "/ticket/" + string(ticketID))
+	if err != nil || resp.StatusCode != 200 {
+		t.Fatalf("could not show ticket err=%v", err)
+	}
+	body := readBody(resp)
+	if strings.Contains(body, "<script>") || !strings.Contains(body, "&lt;script&gt;") {
+		t.Errorf("username not escaped in HTML view: %v", body)
+	}
+}
@@ -0,0 +1,64 @@
+package ticket
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kit/kit/metrics"
+)
+
+// observe records in latency the seconds since begin of a call to method of repository.
+func observe(latency metrics.Histogram, repository, method string, begin time.Time) {
+	latency.With("repository", repository, "method", method).Observe(time.Since(begin).Seconds())
+}
+
+type instrumentingRepository struct {
+	latency metrics.Histogram
+	next    Repository
+}
+
+// NewInstrumentingRepository returns a Repository which records latency of calls to r in seconds,
+// labelled by repository and method.
+func NewInstrumentingRepository(latency metrics.Histogram, r Repository) Repository {
+	return instrumentingRepository{latency: latency, next: r}
+}
+
+func (r instrumentingRepository) Insert(ctx context.Context, t Ticket) (ID, error) {
+	defer observe(r.latency, "tickets", "insert", time.Now())
+	return r.next.Insert(ctx, t)
+}
+
+func (r instrumentingRepository) Find(ctx context.Context, id ID) (Ticket, error) {
+	defer observe(r.latency, "tickets", "find", time.Now())
+	return r.next.Find(ctx, id)
+}
+
+func (r instrumentingRepository) InsertStrip(ctx context.Context, ts []Ticket) (StripID, []ID, error) {
+	defer observe(r.latency, "tickets", "insert_strip", time.Now())
+	return r.next.InsertStrip(ctx, ts)
+}
+
+func (r instrumentingRepository) Mark(ctx context.Context, id ID, number int64) error {
+	defer observe(r.latency, "tickets", "mark", time.Now())
+	return r.next.Mark(ctx, id, number)
+}
+
+func (r instrumentingRepository) Unmark(ctx context.Context, id ID, number int64) error {
+	defer observe(r.latency, "tickets", "unmark", time.Now())
+	return r.next.Unmark(ctx, id, number)
+}
+
+func (r instrumentingRepository) SetAutoDaub(ctx context.Context, id ID, enabled bool) error {
+	defer observe(r.latency, "tickets", "set_auto_daub", time.Now())
+	return r.next.SetAutoDaub(ctx, id, enabled)
+}
+
+func (r instrumentingRepository) FindByGame(ctx context.Context, gameID string, offset, limit int) ([]Ticket, int, error) {
+	defer observe(r.latency, "tickets", "find_by_game", time.Now())
+	return r.next.FindByGame(ctx, gameID, offset, limit)
+}
+
+func (r instrumentingRepository) CountByUser(ctx context.Context, gameID, username string) (int, error) {
+	defer observe(r.latency, "tickets", "count_by_user", time.Now())
+	return r.next.CountByUser(ctx, gameID, username)
+}
@@ -2,23 +2,56 @@ package ticket
 
 import (
 	"context"
-	"errors"
+	"encoding/hex"
+	"net/http"
+	"time"
+
+	"github.com/divyanshgaba/bingo/errs"
 )
 
 // errors for package ticket
 var (
-	ErrInvalidID = errors.New("ticket: invalid ID")
+	ErrInvalidID = errs.New("ticket.invalid_id", http.StatusBadRequest, "ticket: invalid ID")
+)
+
+// values of cells without a number
+const (
+	EmptyCell int64 = -1
+	FreeCell  int64 = -2 // free space, always counts as drawn
 )
 
 // ID uniquely identifies a particular ticket.
 type ID string
 
+// Valid reports whether id is well-formed, 24 hexadecimal characters as IDs are generated by stores. IDs which
+// are not well-formed can be rejected without looking them up.
+func (id ID) Valid() bool {
+	if len(id) != 24 {
+		return false
+	}
+	_, err := hex.DecodeString(string(id))
+	return err == nil
+}
+
+// StripID identifies tickets sold together as a strip.
+type StripID string
+
 // Ticket is the central class in the domain model.
-// Each ticket is represented in string with each cell seperated by a semicolon(;).
+// Each ticket is represented in string with each cell seperated by a semicolon(;), row by row.
+// Rows and Cols are zero for tickets created before formats were introduced, which are 3x9.
 type Ticket struct {
-	ID       ID
-	Username string
-	Cells    string
+	ID         ID
+	GameID     string // ID of the game, empty for tickets created before tickets referenced their game
+	StripID    StripID
+	Username   string
+	Rows, Cols int
+	Cells      string
+	// Nonce from which cells are derived along with seed of the game, for games played with a seed.
+	Nonce string
+	// Marked are numbers daubed by the player. With AutoDaub, numbers drawn are daubed as well.
+	Marked    []int64
+	AutoDaub  bool
+	CreatedAt time.Time
 }
 
 // New creates a new ticket
@@ -34,4 +67,14 @@ func New(id ID, username, cells string) *Ticket {
 type Repository interface {
 	Insert(context.Context, Ticket) (ID, error)
 	Find(context.Context, ID) (Ticket, error)
+	// InsertStrip stores all tickets or none of them, linked by a new strip ID.
+	InsertStrip(context.Context, []Ticket) (StripID, []ID, error)
+	Mark(ctx context.Context, id ID, number int64) error
+	Unmark(ctx context.Context, id ID, number int64) error
+	SetAutoDaub(ctx context.Context, id ID, enabled bool) error
+	// FindByGame returns up to limit tickets of a game in order of creation, skipping the first offset,
+	// along with the total count of tickets of the game.
+	FindByGame(ctx context.Context, gameID string, offset, limit int) ([]Ticket, int, error)
+	// CountByUser returns the count of tickets of a game created for username.
+	CountByUser(ctx context.Context, gameID, username string) (int, error)
 }